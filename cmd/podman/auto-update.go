@@ -2,9 +2,15 @@ package main
 
 import (
 	"fmt"
+	"os"
+	"text/tabwriter"
+	"text/template"
 
 	"github.com/containers/common/pkg/auth"
 	"github.com/containers/common/pkg/completion"
+	"github.com/containers/common/pkg/report"
+	"github.com/containers/podman/v2/cmd/podman/common"
+	"github.com/containers/podman/v2/cmd/podman/parse"
 	"github.com/containers/podman/v2/cmd/podman/registry"
 	"github.com/containers/podman/v2/pkg/domain/entities"
 	"github.com/containers/podman/v2/pkg/errorhandling"
@@ -12,8 +18,13 @@ import (
 	"github.com/spf13/cobra"
 )
 
+type cliAutoUpdateOptions struct {
+	entities.AutoUpdateOptions
+	format string
+}
+
 var (
-	autoUpdateOptions     = entities.AutoUpdateOptions{}
+	autoUpdateOptions     = cliAutoUpdateOptions{}
 	autoUpdateDescription = `Auto update containers according to their auto-update policy.
 
   Auto-update policies are specified with the "io.containers.autoupdate" label.
@@ -27,13 +38,15 @@ var (
 		RunE:              autoUpdate,
 		ValidArgsFunction: completion.AutocompleteNone,
 		Example: `podman auto-update
-  podman auto-update --authfile ~/authfile.json`,
+  podman auto-update --authfile ~/authfile.json
+  podman auto-update --rollback
+  podman auto-update --dry-run --format json`,
 	}
 )
 
 func init() {
 	registry.Commands = append(registry.Commands, registry.CliCommand{
-		Mode:    []entities.EngineMode{entities.ABIMode},
+		Mode:    []entities.EngineMode{entities.ABIMode, entities.TunnelMode},
 		Command: autoUpdateCommand,
 	})
 
@@ -42,6 +55,13 @@ func init() {
 	authfileFlagName := "authfile"
 	flags.StringVar(&autoUpdateOptions.Authfile, authfileFlagName, auth.GetDefaultAuthFile(), "Path to the authentication file. Use REGISTRY_AUTH_FILE environment variable to override")
 	_ = autoUpdateCommand.RegisterFlagCompletionFunc(authfileFlagName, completion.AutocompleteDefault)
+
+	flags.BoolVar(&autoUpdateOptions.Rollback, "rollback", false, "Roll back to the previous image if the update fails")
+	flags.BoolVar(&autoUpdateOptions.DryRun, "dry-run", false, "Check for pending updates without pulling images or restarting systemd units")
+
+	formatFlagName := "format"
+	flags.StringVar(&autoUpdateOptions.format, formatFlagName, "", "Change the output format to JSON or a Go template")
+	_ = autoUpdateCommand.RegisterFlagCompletionFunc(formatFlagName, common.AutocompleteJSONFormat)
 }
 
 func autoUpdate(cmd *cobra.Command, args []string) error {
@@ -49,11 +69,53 @@ func autoUpdate(cmd *cobra.Command, args []string) error {
 		// Backwards compat. System tests expect this error string.
 		return errors.Errorf("`%s` takes no arguments", cmd.CommandPath())
 	}
-	report, failures := registry.ContainerEngine().AutoUpdate(registry.GetContext(), autoUpdateOptions)
-	if report != nil {
-		for _, unit := range report.Units {
-			fmt.Println(unit)
+	reports, failures := registry.ContainerEngine().AutoUpdate(registry.GetContext(), autoUpdateOptions.AutoUpdateOptions)
+	if len(reports) > 0 {
+		if err := writeAutoUpdateReports(cmd, reports); err != nil {
+			failures = append(failures, err)
 		}
 	}
 	return errorhandling.JoinErrors(failures)
 }
+
+func writeAutoUpdateReports(cmd *cobra.Command, reports []*entities.AutoUpdateReport) error {
+	if report.IsJSON(autoUpdateOptions.format) {
+		json := registry.JSONLibrary()
+		b, err := json.MarshalIndent(reports, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(b))
+		return nil
+	}
+
+	row := "{{.SystemdUnit}}\t{{.ContainerName}}\t{{.ImageName}}\t{{.Policy}}\t{{.Updated}}\n"
+	if autoUpdateOptions.DryRun {
+		row = "{{.SystemdUnit}}\t{{.ContainerName}}\t{{.ImageName}}\t{{.Policy}}\t{{.CurrentDigest}}\t{{.CandidateDigest}}\t{{.Updated}}\n"
+	}
+	if cmd.Flag("format").Changed {
+		row = report.NormalizeFormat(autoUpdateOptions.format)
+	}
+	format := parse.EnforceRange(row)
+
+	tmpl, err := template.New("auto-update").Parse(format)
+	if err != nil {
+		return err
+	}
+	w := tabwriter.NewWriter(os.Stdout, 12, 2, 2, ' ', 0)
+	defer w.Flush()
+
+	if !cmd.Flag("format").Changed {
+		headers := report.Headers(entities.AutoUpdateReport{}, map[string]string{
+			"SystemdUnit":     "UNIT",
+			"ContainerName":   "CONTAINER",
+			"ImageName":       "IMAGE",
+			"CurrentDigest":   "CURRENT DIGEST",
+			"CandidateDigest": "CANDIDATE DIGEST",
+		})
+		if err := tmpl.Execute(w, headers); err != nil {
+			return errors.Wrapf(err, "failed to write report column headers")
+		}
+	}
+	return tmpl.Execute(w, reports)
+}
@@ -244,6 +244,28 @@ func getNetworks(cmd *cobra.Command, toComplete string) ([]string, cobra.ShellCo
 	return suggestions, cobra.ShellCompDirectiveNoFileComp
 }
 
+func getSecrets(cmd *cobra.Command, toComplete string) ([]string, cobra.ShellCompDirective) {
+	suggestions := []string{}
+
+	engine, err := setupContainerEngine(cmd)
+	if err != nil {
+		cobra.CompErrorln(err.Error())
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	secrets, err := engine.SecretList(registry.Context())
+	if err != nil {
+		cobra.CompErrorln(err.Error())
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	for _, s := range secrets {
+		if strings.HasPrefix(s.Spec.Name, toComplete) {
+			suggestions = append(suggestions, s.Spec.Name)
+		}
+	}
+	return suggestions, cobra.ShellCompDirectiveNoFileComp
+}
+
 // validCurrentCmdLine validates the current cmd line
 // It utilizes the Args function from the cmd struct
 // In most cases the Args function validates the args length but it
@@ -445,6 +467,27 @@ func AutocompleteNetworks(cmd *cobra.Command, args []string, toComplete string)
 	return getNetworks(cmd, toComplete)
 }
 
+// AutocompleteSecrets - Autocomplete secrets.
+func AutocompleteSecrets(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if !validCurrentCmdLine(cmd, args, toComplete) {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	return getSecrets(cmd, toComplete)
+}
+
+// AutocompleteSecretCreate - Autocomplete secret create args.
+func AutocompleteSecretCreate(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) == 1 {
+		return nil, cobra.ShellCompDirectiveDefault
+	}
+	return nil, cobra.ShellCompDirectiveNoFileComp
+}
+
+// AutocompleteSecretFlag - Autocomplete the --secret flag of create and run.
+func AutocompleteSecretFlag(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return getSecrets(cmd, toComplete)
+}
+
 // AutocompleteCpCommand - Autocomplete podman cp command args.
 func AutocompleteCpCommand(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 	if !validCurrentCmdLine(cmd, args, toComplete) {
@@ -477,6 +520,28 @@ func AutocompleteNetworkConnectCmd(cmd *cobra.Command, args []string, toComplete
 	return nil, cobra.ShellCompDirectiveNoFileComp
 }
 
+// AutocompleteVolumeImportCmd - Autocomplete podman volume import command args.
+func AutocompleteVolumeImportCmd(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) == 0 {
+		return getVolumes(cmd, toComplete)
+	}
+	if len(args) == 1 {
+		// get the default completion for the archive file
+		return nil, cobra.ShellCompDirectiveDefault
+	}
+	// don't complete more than 2 args
+	return nil, cobra.ShellCompDirectiveNoFileComp
+}
+
+// AutocompleteRenameCmd - Autocomplete podman rename command args.
+func AutocompleteRenameCmd(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) == 0 {
+		return getContainers(cmd, toComplete, completeDefault)
+	}
+	// the new name cannot be completed
+	return nil, cobra.ShellCompDirectiveNoFileComp
+}
+
 // AutocompleteTopCmd - Autocomplete podman top/pod top command args.
 func AutocompleteTopCmd(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 	latest := cmd.Flags().Lookup("latest")
@@ -606,6 +671,12 @@ func AutocompletePullOption(cmd *cobra.Command, args []string, toComplete string
 	return pullOptions, cobra.ShellCompDirectiveNoFileComp
 }
 
+// AutocompleteHealthOnFailure - Autocomplete health on failure options for create and run command.
+// -> "none", "kill", "restart", "stop"
+func AutocompleteHealthOnFailure(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return define.SupportedHealthCheckOnFailureActions, cobra.ShellCompDirectiveNoFileComp
+}
+
 // AutocompleteRestartOption - Autocomplete restart options for create and run command.
 // -> "always", "no", "on-failure", "unless-stopped"
 func AutocompleteRestartOption(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
@@ -824,6 +895,13 @@ func AutocompleteWaitCondition(cmd *cobra.Command, args []string, toComplete str
 	return states, cobra.ShellCompDirectiveNoFileComp
 }
 
+// AutocompleteLogStream - Autocomplete log stream options.
+// -> "stdout", "stderr"
+func AutocompleteLogStream(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	streams := []string{"stdout", "stderr"}
+	return streams, cobra.ShellCompDirectiveNoFileComp
+}
+
 // AutocompleteCgroupManager - Autocomplete cgroup manager options.
 // -> "cgroupfs", "systemd"
 func AutocompleteCgroupManager(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
@@ -302,6 +302,30 @@ func DefineCreateFlags(cmd *cobra.Command, cf *ContainerCLIOpts) {
 	)
 	_ = cmd.RegisterFlagCompletionFunc(healthIntervalFlagName, completion.AutocompleteNone)
 
+	healthMaxLogCountFlagName := "health-max-log-count"
+	createFlags.UintVar(
+		&cf.HealthMaxLogCount,
+		healthMaxLogCountFlagName, DefaultHealthMaxLogCount,
+		"the number of healthcheck results kept in the healthcheck log",
+	)
+	_ = cmd.RegisterFlagCompletionFunc(healthMaxLogCountFlagName, completion.AutocompleteNone)
+
+	healthMaxLogSizeFlagName := "health-max-log-size"
+	createFlags.UintVar(
+		&cf.HealthMaxLogSize,
+		healthMaxLogSizeFlagName, DefaultHealthMaxLogSize,
+		"the maximum length in characters of the healthcheck output kept in the healthcheck log",
+	)
+	_ = cmd.RegisterFlagCompletionFunc(healthMaxLogSizeFlagName, completion.AutocompleteNone)
+
+	healthOnFailureFlagName := "health-on-failure"
+	createFlags.StringVar(
+		&cf.HealthOnFailure,
+		healthOnFailureFlagName, "none",
+		"action to take once the container turns unhealthy",
+	)
+	_ = cmd.RegisterFlagCompletionFunc(healthOnFailureFlagName, AutocompleteHealthOnFailure)
+
 	healthRetriesFlagName := "health-retries"
 	createFlags.UintVar(
 		&cf.HealthRetries,
@@ -318,6 +342,38 @@ func DefineCreateFlags(cmd *cobra.Command, cf *ContainerCLIOpts) {
 	)
 	_ = cmd.RegisterFlagCompletionFunc(healthStartPeriodFlagName, completion.AutocompleteNone)
 
+	healthStartupCmdFlagName := "health-startup-cmd"
+	createFlags.StringVar(
+		&cf.StartupHCCmd,
+		healthStartupCmdFlagName, "",
+		"set a startup healthcheck command for the container, which runs until it succeeds once",
+	)
+	_ = cmd.RegisterFlagCompletionFunc(healthStartupCmdFlagName, completion.AutocompleteNone)
+
+	healthStartupIntervalFlagName := "health-startup-interval"
+	createFlags.StringVar(
+		&cf.StartupHCInterval,
+		healthStartupIntervalFlagName, DefaultHealthCheckInterval,
+		"set an interval for the startup healthcheck (a value of disable results in no automatic timer setup)",
+	)
+	_ = cmd.RegisterFlagCompletionFunc(healthStartupIntervalFlagName, completion.AutocompleteNone)
+
+	healthStartupRetriesFlagName := "health-startup-retries"
+	createFlags.UintVar(
+		&cf.StartupHCRetries,
+		healthStartupRetriesFlagName, DefaultHealthCheckRetries,
+		"the number of failed startup healthchecks after which the container is restarted",
+	)
+	_ = cmd.RegisterFlagCompletionFunc(healthStartupRetriesFlagName, completion.AutocompleteNone)
+
+	healthStartupTimeoutFlagName := "health-startup-timeout"
+	createFlags.StringVar(
+		&cf.StartupHCTimeout,
+		healthStartupTimeoutFlagName, DefaultHealthCheckTimeout,
+		"the maximum time allowed to complete the startup healthcheck before an interval is considered failed",
+	)
+	_ = cmd.RegisterFlagCompletionFunc(healthStartupTimeoutFlagName, completion.AutocompleteNone)
+
 	healthTimeoutFlagName := "health-timeout"
 	createFlags.StringVar(
 		&cf.HealthTimeout,
@@ -596,6 +652,14 @@ func DefineCreateFlags(cmd *cobra.Command, cf *ContainerCLIOpts) {
 	)
 	_ = cmd.RegisterFlagCompletionFunc(sdnotifyFlagName, AutocompleteSDNotify)
 
+	secretFlagName := "secret"
+	createFlags.StringArrayVar(
+		&cf.Secrets,
+		secretFlagName, []string{},
+		"Add secret to container",
+	)
+	_ = cmd.RegisterFlagCompletionFunc(secretFlagName, AutocompleteSecretFlag)
+
 	securityOptFlagName := "security-opt"
 	createFlags.StringArrayVar(
 		&cf.SecurityOpt,
@@ -50,6 +50,9 @@ type ContainerCLIOpts struct {
 	GroupAdd          []string
 	HealthCmd         string
 	HealthInterval    string
+	HealthMaxLogCount uint
+	HealthMaxLogSize  uint
+	HealthOnFailure   string
 	HealthRetries     uint
 	HealthStartPeriod string
 	HealthTimeout     string
@@ -91,10 +94,15 @@ type ContainerCLIOpts struct {
 	Replace           bool
 	Rm                bool
 	RootFS            bool
+	Secrets           []string
 	SecurityOpt       []string
 	SdNotifyMode      string
 	ShmSize           string
 	SignaturePolicy   string
+	StartupHCCmd      string
+	StartupHCInterval string
+	StartupHCRetries  uint
+	StartupHCTimeout  string
 	StopSignal        string
 	StopTimeout       uint
 	StoreageOpt       []string
@@ -150,7 +150,6 @@ func getMemoryLimits(s *specgen.SpecGenerator, c *ContainerCLIOpts) (*specs.Linu
 		var ms int64
 		if m == "-1" {
 			ms = int64(-1)
-			s.ResourceLimits.Memory.Swap = &ms
 		} else {
 			ms, err = units.RAMInBytes(m)
 			if err != nil {
@@ -183,6 +182,28 @@ func getMemoryLimits(s *specgen.SpecGenerator, c *ContainerCLIOpts) (*specs.Linu
 	return memory, nil
 }
 
+// GetResourceLimits returns the CPU, memory, pids and block IO limits set in
+// the given options. Limits that are not set are nil.
+func GetResourceLimits(c *ContainerCLIOpts) (*specs.LinuxResources, error) {
+	var err error
+	resources := &specs.LinuxResources{}
+	resources.Memory, err = getMemoryLimits(&specgen.SpecGenerator{}, c)
+	if err != nil {
+		return nil, err
+	}
+	resources.BlockIO, err = getIOLimits(&specgen.SpecGenerator{}, c)
+	if err != nil {
+		return nil, err
+	}
+	if c.PIDsLimit != nil {
+		resources.Pids = &specs.LinuxPids{
+			Limit: *c.PIDsLimit,
+		}
+	}
+	resources.CPU = getCPULimits(c)
+	return resources, nil
+}
+
 func setNamespaces(s *specgen.SpecGenerator, c *ContainerCLIOpts) error {
 	var err error
 
@@ -248,6 +269,22 @@ func FillOutSpecGen(s *specgen.SpecGenerator, c *ContainerCLIOpts, args []string
 			Test: []string{"NONE"},
 		}
 	}
+	if len(c.StartupHCCmd) > 0 {
+		if c.NoHealthCheck {
+			return errors.New("Cannot specify both --no-healthcheck and --health-startup-cmd")
+		}
+		s.StartupHealthConfig, err = makeHealthCheckFromCli(c.StartupHCCmd, c.StartupHCInterval, c.StartupHCRetries, c.StartupHCTimeout, "0s")
+		if err != nil {
+			return err
+		}
+	}
+	s.HealthMaxLogCount = c.HealthMaxLogCount
+	s.HealthMaxLogSize = c.HealthMaxLogSize
+	onFailureAction, err := define.ParseHealthCheckOnFailureAction(c.HealthOnFailure)
+	if err != nil {
+		return err
+	}
+	s.HealthCheckOnFailureAction = onFailureAction
 
 	userNS := ns.UsernsMode(c.UserNS)
 	s.IDMappings, err = util.ParseIDMapping(userNS, c.UIDMap, c.GIDMap, c.SubUIDName, c.SubGIDName)
@@ -595,6 +632,18 @@ func FillOutSpecGen(s *specgen.SpecGenerator, c *ContainerCLIOpts, args []string
 				return err
 			}
 			s.LogConfiguration.Size = logSize
+		case "max-file":
+			maxFiles, err := strconv.ParseUint(split[1], 10, 32)
+			if err != nil || maxFiles < 1 {
+				return errors.Errorf("invalid max-file log option %q: must be a positive integer", split[1])
+			}
+			s.LogConfiguration.MaxFiles = uint(maxFiles)
+		case "compress":
+			compress, err := strconv.ParseBool(split[1])
+			if err != nil {
+				return errors.Wrapf(err, "invalid compress log option %q", split[1])
+			}
+			s.LogConfiguration.Compress = compress
 		default:
 			logOpts[split[0]] = split[1]
 		}
@@ -631,6 +680,10 @@ func FillOutSpecGen(s *specgen.SpecGenerator, c *ContainerCLIOpts, args []string
 	s.StopTimeout = &c.StopTimeout
 	s.Timezone = c.Timezone
 	s.Umask = c.Umask
+	s.Secrets, s.EnvSecrets, err = parseSecrets(c.Secrets)
+	if err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -757,3 +810,73 @@ func parseThrottleIOPsDevices(iopsDevices []string) (map[string]specs.LinuxThrot
 	}
 	return td, nil
 }
+
+// parseSecrets parses the --secret flag. The syntax is
+// name[,type=mount|env][,target=ENVVAR]. Secrets of type mount are mounted
+// at /run/secrets/name, secrets of type env are exposed as the environment
+// variable target, which defaults to the name of the secret.
+func parseSecrets(secrets []string) ([]string, map[string]string, error) {
+	secretParseError := errors.New("error parsing secret")
+	var mount []string
+	envs := make(map[string]string)
+	for _, val := range secrets {
+		source := ""
+		secretType := ""
+		target := ""
+		split := strings.Split(val, ",")
+
+		// --secret mysecret
+		if len(split) == 1 {
+			source = val
+			mount = append(mount, source)
+			continue
+		}
+		// --secret mysecret,opt=opt
+		if !strings.Contains(split[0], "=") {
+			source = split[0]
+			split = split[1:]
+		}
+		for _, opt := range split {
+			kv := strings.SplitN(opt, "=", 2)
+			if len(kv) != 2 {
+				return nil, nil, errors.Wrapf(secretParseError, "option %s must be in form option=value", opt)
+			}
+			switch kv[0] {
+			case "source":
+				source = kv[1]
+			case "type":
+				if secretType != "" {
+					return nil, nil, errors.Wrap(secretParseError, "cannot set more than one secret type")
+				}
+				if kv[1] != "mount" && kv[1] != "env" {
+					return nil, nil, errors.Wrapf(secretParseError, "type %s is invalid", kv[1])
+				}
+				secretType = kv[1]
+			case "target":
+				target = kv[1]
+			default:
+				return nil, nil, errors.Wrapf(secretParseError, "option %s invalid", opt)
+			}
+		}
+		if source == "" {
+			return nil, nil, errors.Wrapf(secretParseError, "no source found %s", val)
+		}
+		if secretType == "" {
+			secretType = "mount"
+		}
+
+		switch secretType {
+		case "mount":
+			if target != "" {
+				return nil, nil, errors.Wrap(secretParseError, "target option is invalid for mounted secrets")
+			}
+			mount = append(mount, source)
+		case "env":
+			if target == "" {
+				target = source
+			}
+			envs[target] = source
+		}
+	}
+	return mount, envs, nil
+}
@@ -32,7 +32,10 @@ var (
 		ValidArgsFunction: common.AutocompleteContainersRunning,
 		Example: `podman container checkpoint --keep ctrID
   podman container checkpoint --all
-  podman container checkpoint --leave-running --latest`,
+  podman container checkpoint --leave-running --latest
+  podman container checkpoint --pre-checkpoint ctrID
+  podman container checkpoint --with-previous --export /tmp/ctr.tar.gz ctrID
+  podman container checkpoint --create-image quay.io/example/ctr-checkpoint ctrID`,
 	}
 )
 
@@ -57,6 +60,12 @@ func init() {
 	_ = checkpointCommand.RegisterFlagCompletionFunc(exportFlagName, completion.AutocompleteDefault)
 
 	flags.BoolVar(&checkpointOptions.IgnoreRootFS, "ignore-rootfs", false, "Do not include root file-system changes when exporting")
+
+	createImageFlagName := "create-image"
+	flags.StringVarP(&checkpointOptions.CreateImage, createImageFlagName, "c", "", "Create a checkpoint image with the given name")
+	_ = checkpointCommand.RegisterFlagCompletionFunc(createImageFlagName, completion.AutocompleteNone)
+	flags.BoolVarP(&checkpointOptions.PreCheckPoint, "pre-checkpoint", "P", false, "Dump the memory pages of the container while it keeps running")
+	flags.BoolVar(&checkpointOptions.WithPrevious, "with-previous", false, "Only dump the memory pages changed since the last pre-checkpoint")
 	validate.AddLatestFlag(checkpointCommand, &checkpointOptions.Latest)
 }
 
@@ -65,8 +74,14 @@ func checkpoint(cmd *cobra.Command, args []string) error {
 	if rootless.IsRootless() {
 		return errors.New("checkpointing a container requires root")
 	}
-	if checkpointOptions.Export == "" && checkpointOptions.IgnoreRootFS {
-		return errors.Errorf("--ignore-rootfs can only be used with --export")
+	if checkpointOptions.Export == "" && checkpointOptions.CreateImage == "" && checkpointOptions.IgnoreRootFS {
+		return errors.Errorf("--ignore-rootfs can only be used with --export or --create-image")
+	}
+	if checkpointOptions.PreCheckPoint && (checkpointOptions.Export != "" || checkpointOptions.CreateImage != "" || checkpointOptions.LeaveRunning) {
+		return errors.Errorf("--pre-checkpoint cannot be used with --export, --create-image or --leave-running")
+	}
+	if checkpointOptions.CreateImage != "" && (checkpointOptions.All || len(args) > 1) {
+		return errors.Errorf("--create-image can only be used with a single container")
 	}
 	responses, err := registry.ContainerEngine().ContainerCheckpoint(context.Background(), args, checkpointOptions)
 	if err != nil {
@@ -19,6 +19,7 @@ type logsOptionsWrapper struct {
 	entities.ContainerLogsOptions
 
 	SinceRaw string
+	UntilRaw string
 }
 
 var (
@@ -50,6 +51,7 @@ var (
   podman logs --names ctrID1 ctrID2
   podman logs --tail 2 mywebserver
   podman logs --follow=true --since 10m ctrID
+  podman logs --since 1h --until 10m --stream stderr ctrID
   podman logs mywebserver mydbserver`,
 	}
 
@@ -64,6 +66,7 @@ var (
 		podman container logs --names ctrID1 ctrID2
 		podman container logs --tail 2 mywebserver
 		podman container logs --follow=true --since 10m ctrID
+		podman container logs --since 1h --until 10m --stream stderr ctrID
 		podman container logs mywebserver mydbserver`,
 	}
 )
@@ -97,6 +100,14 @@ func logsFlags(cmd *cobra.Command) {
 	flags.StringVar(&logsOptions.SinceRaw, sinceFlagName, "", "Show logs since TIMESTAMP")
 	_ = cmd.RegisterFlagCompletionFunc(sinceFlagName, completion.AutocompleteNone)
 
+	untilFlagName := "until"
+	flags.StringVar(&logsOptions.UntilRaw, untilFlagName, "", "Show logs until TIMESTAMP")
+	_ = cmd.RegisterFlagCompletionFunc(untilFlagName, completion.AutocompleteNone)
+
+	streamFlagName := "stream"
+	flags.StringVar(&logsOptions.Stream, streamFlagName, "", "Only show logs of the given stream (stdout or stderr)")
+	_ = cmd.RegisterFlagCompletionFunc(streamFlagName, common.AutocompleteLogStream)
+
 	tailFlagName := "tail"
 	flags.Int64Var(&logsOptions.Tail, tailFlagName, -1, "Output the specified number of LINES at the end of the logs.  Defaults to -1, which prints all lines")
 	_ = cmd.RegisterFlagCompletionFunc(tailFlagName, completion.AutocompleteNone)
@@ -116,6 +127,14 @@ func logs(_ *cobra.Command, args []string) error {
 		}
 		logsOptions.Since = since
 	}
+	if logsOptions.UntilRaw != "" {
+		// parse time, error out if something is wrong
+		until, err := util.ParseInputTime(logsOptions.UntilRaw)
+		if err != nil {
+			return errors.Wrapf(err, "error parsing --until %q", logsOptions.UntilRaw)
+		}
+		logsOptions.Until = until
+	}
 	logsOptions.Writer = os.Stdout
 	return registry.ContainerEngine().ContainerLogs(registry.GetContext(), args, logsOptions.ContainerLogsOptions)
 }
@@ -20,9 +20,10 @@ var (
    podman container restore
 
    Restores a container from a checkpoint. The container name or ID can be used.
+   If no container with the name or ID exists, the container is restored from the checkpoint image with that name.
 `
 	restoreCommand = &cobra.Command{
-		Use:   "restore [options] CONTAINER [CONTAINER...]",
+		Use:   "restore [options] CONTAINER|IMAGE [CONTAINER|IMAGE...]",
 		Short: "Restores one or more containers from a checkpoint",
 		Long:  restoreDescription,
 		RunE:  restore,
@@ -32,7 +33,8 @@ var (
 		ValidArgsFunction: common.AutocompleteContainers,
 		Example: `podman container restore ctrID
   podman container restore --latest
-  podman container restore --all`,
+  podman container restore --all
+  podman container restore quay.io/example/ctr-checkpoint`,
 	}
 )
 
@@ -70,8 +72,9 @@ func restore(_ *cobra.Command, args []string) error {
 	if rootless.IsRootless() {
 		return errors.New("restoring a container requires root")
 	}
-	if restoreOptions.Import == "" && restoreOptions.IgnoreRootFS {
-		return errors.Errorf("--ignore-rootfs can only be used with --import")
+	// Whether the arguments are checkpoint images is checked by the engine.
+	if restoreOptions.Import == "" && restoreOptions.IgnoreRootFS && len(args) == 0 {
+		return errors.Errorf("--ignore-rootfs can only be used with --import or a checkpoint image")
 	}
 	if restoreOptions.Import == "" && restoreOptions.Name != "" {
 		return errors.Errorf("--name can only be used with --import")
@@ -0,0 +1,149 @@
+package containers
+
+import (
+	"fmt"
+
+	"github.com/containers/common/pkg/completion"
+	"github.com/containers/podman/v2/cmd/podman/common"
+	"github.com/containers/podman/v2/cmd/podman/registry"
+	"github.com/containers/podman/v2/pkg/domain/entities"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var (
+	updateDescription = `Updates the resource limits of a container.
+
+  Only the given limits are changed. If the container is running, the new limits are applied immediately.`
+
+	updateCommand = &cobra.Command{
+		Use:               "update [options] CONTAINER",
+		Short:             "Update the resource limits of a container",
+		Long:              updateDescription,
+		RunE:              update,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: common.AutocompleteContainers,
+		Example: `podman update --cpu-shares 512 ctrID
+  podman update --memory 512m --memory-swap 1g ctrID`,
+	}
+
+	containerUpdateCommand = &cobra.Command{
+		Use:               updateCommand.Use,
+		Short:             updateCommand.Short,
+		Long:              updateCommand.Long,
+		RunE:              updateCommand.RunE,
+		Args:              updateCommand.Args,
+		ValidArgsFunction: updateCommand.ValidArgsFunction,
+		Example: `podman container update --cpu-shares 512 ctrID
+  podman container update --pids-limit 100 ctrID`,
+	}
+
+	updateOpts = common.ContainerCLIOpts{}
+)
+
+func updateFlags(cmd *cobra.Command) {
+	flags := cmd.Flags()
+
+	blkioWeightFlagName := "blkio-weight"
+	flags.StringVar(&updateOpts.BlkIOWeight, blkioWeightFlagName, "", "Block IO weight (relative weight) accepts a weight value between 10 and 1000.")
+	_ = cmd.RegisterFlagCompletionFunc(blkioWeightFlagName, completion.AutocompleteNone)
+
+	cpuPeriodFlagName := "cpu-period"
+	flags.Uint64Var(&updateOpts.CPUPeriod, cpuPeriodFlagName, 0, "Limit the CPU CFS (Completely Fair Scheduler) period")
+	_ = cmd.RegisterFlagCompletionFunc(cpuPeriodFlagName, completion.AutocompleteNone)
+
+	cpuQuotaFlagName := "cpu-quota"
+	flags.Int64Var(&updateOpts.CPUQuota, cpuQuotaFlagName, 0, "Limit the CPU CFS (Completely Fair Scheduler) quota")
+	_ = cmd.RegisterFlagCompletionFunc(cpuQuotaFlagName, completion.AutocompleteNone)
+
+	cpuRtPeriodFlagName := "cpu-rt-period"
+	flags.Uint64Var(&updateOpts.CPURTPeriod, cpuRtPeriodFlagName, 0, "Limit the CPU real-time period in microseconds")
+	_ = cmd.RegisterFlagCompletionFunc(cpuRtPeriodFlagName, completion.AutocompleteNone)
+
+	cpuRtRuntimeFlagName := "cpu-rt-runtime"
+	flags.Int64Var(&updateOpts.CPURTRuntime, cpuRtRuntimeFlagName, 0, "Limit the CPU real-time runtime in microseconds")
+	_ = cmd.RegisterFlagCompletionFunc(cpuRtRuntimeFlagName, completion.AutocompleteNone)
+
+	cpuSharesFlagName := "cpu-shares"
+	flags.Uint64Var(&updateOpts.CPUShares, cpuSharesFlagName, 0, "CPU shares (relative weight)")
+	_ = cmd.RegisterFlagCompletionFunc(cpuSharesFlagName, completion.AutocompleteNone)
+
+	cpusFlagName := "cpus"
+	flags.Float64Var(&updateOpts.CPUS, cpusFlagName, 0, "Number of CPUs")
+	_ = cmd.RegisterFlagCompletionFunc(cpusFlagName, completion.AutocompleteNone)
+
+	cpusetCpusFlagName := "cpuset-cpus"
+	flags.StringVar(&updateOpts.CPUSetCPUs, cpusetCpusFlagName, "", "CPUs in which to allow execution (0-3, 0,1)")
+	_ = cmd.RegisterFlagCompletionFunc(cpusetCpusFlagName, completion.AutocompleteNone)
+
+	cpusetMemsFlagName := "cpuset-mems"
+	flags.StringVar(&updateOpts.CPUSetMems, cpusetMemsFlagName, "", "Memory nodes (MEMs) in which to allow execution (0-3, 0,1). Only effective on NUMA systems.")
+	_ = cmd.RegisterFlagCompletionFunc(cpusetMemsFlagName, completion.AutocompleteNone)
+
+	memoryFlagName := "memory"
+	flags.StringVarP(&updateOpts.Memory, memoryFlagName, "m", "", "Memory limit (format: `<number>[<unit>]`, where unit = b (bytes), k (kilobytes), m (megabytes), or g (gigabytes))")
+	_ = cmd.RegisterFlagCompletionFunc(memoryFlagName, completion.AutocompleteNone)
+
+	memoryReservationFlagName := "memory-reservation"
+	flags.StringVar(&updateOpts.MemoryReservation, memoryReservationFlagName, "", "Memory soft limit (format: `<number>[<unit>]`, where unit = b (bytes), k (kilobytes), m (megabytes), or g (gigabytes))")
+	_ = cmd.RegisterFlagCompletionFunc(memoryReservationFlagName, completion.AutocompleteNone)
+
+	memorySwapFlagName := "memory-swap"
+	flags.StringVar(&updateOpts.MemorySwap, memorySwapFlagName, "", "Swap limit equal to memory plus swap: '-1' to enable unlimited swap")
+	_ = cmd.RegisterFlagCompletionFunc(memorySwapFlagName, completion.AutocompleteNone)
+
+	memorySwappinessFlagName := "memory-swappiness"
+	flags.Int64Var(&updateOpts.MemorySwappiness, memorySwappinessFlagName, -1, "Tune container memory swappiness (0 to 100, or -1 to leave it unchanged)")
+	_ = cmd.RegisterFlagCompletionFunc(memorySwappinessFlagName, completion.AutocompleteNone)
+
+	pidsLimitFlagName := "pids-limit"
+	flags.Int64(pidsLimitFlagName, 0, "Tune container pids limit (set 0 for unlimited)")
+	_ = cmd.RegisterFlagCompletionFunc(pidsLimitFlagName, completion.AutocompleteNone)
+}
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Mode:    []entities.EngineMode{entities.ABIMode, entities.TunnelMode},
+		Command: updateCommand,
+	})
+	updateFlags(updateCommand)
+
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Mode:    []entities.EngineMode{entities.ABIMode, entities.TunnelMode},
+		Command: containerUpdateCommand,
+		Parent:  containerCmd,
+	})
+	updateFlags(containerUpdateCommand)
+}
+
+func update(cmd *cobra.Command, args []string) error {
+	if cmd.Flags().Changed("pids-limit") {
+		pidsLimit, err := cmd.Flags().GetInt64("pids-limit")
+		if err != nil {
+			return err
+		}
+		// The OCI runtimes ignore a limit of 0 on update, -1 removes
+		// the limit.
+		if pidsLimit == 0 {
+			pidsLimit = -1
+		}
+		updateOpts.PIDsLimit = &pidsLimit
+	}
+
+	resources, err := common.GetResourceLimits(&updateOpts)
+	if err != nil {
+		return err
+	}
+	if resources.CPU == nil && resources.Memory == nil && resources.Pids == nil && resources.BlockIO == nil {
+		return errors.New("at least one resource limit must be given")
+	}
+
+	updateOptions := entities.ContainerUpdateOptions{
+		Resources: resources,
+	}
+	if err := registry.ContainerEngine().ContainerUpdate(registry.GetContext(), args[0], updateOptions); err != nil {
+		return err
+	}
+	fmt.Println(args[0])
+	return nil
+}
@@ -1,13 +1,32 @@
 package images
 
 import (
+	"fmt"
+	"os"
+	"text/template"
+
+	"github.com/containers/common/pkg/auth"
+	"github.com/containers/common/pkg/completion"
+	"github.com/containers/common/pkg/report"
+	"github.com/containers/image/v5/types"
 	"github.com/containers/podman/v2/cmd/podman/common"
 	"github.com/containers/podman/v2/cmd/podman/inspect"
 	"github.com/containers/podman/v2/cmd/podman/registry"
 	"github.com/containers/podman/v2/pkg/domain/entities"
+	"github.com/containers/podman/v2/pkg/util"
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 )
 
+// registryInspectOptionsWrapper wraps entities.ImageRegistryInspectOptions
+// and prevents leaking CLI-only fields into the API types.
+type registryInspectOptionsWrapper struct {
+	entities.ImageRegistryInspectOptions
+	RemoteRegistry bool // CLI only
+	TLSVerifyCLI   bool // CLI only
+	CredentialsCLI string
+}
+
 var (
 	// Command: podman image _inspect_
 	inspectCmd = &cobra.Command{
@@ -18,9 +37,11 @@ var (
 		ValidArgsFunction: common.AutocompleteImages,
 		Example: `podman inspect alpine
   podman inspect --format "imageId: {{.Id}} size: {{.Size}}" alpine
-  podman inspect --format "image: {{.ImageName}} driver: {{.Driver}}" myctr`,
+  podman inspect --format "image: {{.ImageName}} driver: {{.Driver}}" myctr
+  podman image inspect --remote-registry --format "{{.Descriptor.Digest}}" quay.io/libpod/alpine`,
 	}
-	inspectOpts *entities.InspectOptions
+	inspectOpts         *entities.InspectOptions
+	registryInspectOpts = registryInspectOptionsWrapper{}
 )
 
 func init() {
@@ -35,9 +56,82 @@ func init() {
 	formatFlagName := "format"
 	flags.StringVarP(&inspectOpts.Format, formatFlagName, "f", "json", "Format the output to a Go template or json")
 	_ = inspectCmd.RegisterFlagCompletionFunc(formatFlagName, common.AutocompleteJSONFormat)
+
+	flags.BoolVar(&registryInspectOpts.RemoteRegistry, "remote-registry", false, "Inspect the images in their registries without pulling them")
+
+	authfileFlagName := "authfile"
+	flags.StringVar(&registryInspectOpts.Authfile, authfileFlagName, auth.GetDefaultAuthFile(), "Path of the authentication file. Use REGISTRY_AUTH_FILE environment variable to override")
+	_ = inspectCmd.RegisterFlagCompletionFunc(authfileFlagName, completion.AutocompleteDefault)
+
+	credsFlagName := "creds"
+	flags.StringVar(&registryInspectOpts.CredentialsCLI, credsFlagName, "", "`Credentials` (USERNAME:PASSWORD) to use for authenticating to a registry")
+	_ = inspectCmd.RegisterFlagCompletionFunc(credsFlagName, completion.AutocompleteNone)
+
+	flags.BoolVar(&registryInspectOpts.TLSVerifyCLI, "tls-verify", true, "Require HTTPS and verify certificates when contacting registries")
+
+	if !registry.IsRemote() {
+		certDirFlagName := "cert-dir"
+		flags.StringVar(&registryInspectOpts.CertDir, certDirFlagName, "", "`Pathname` of a directory containing TLS certificates and keys")
+		_ = inspectCmd.RegisterFlagCompletionFunc(certDirFlagName, completion.AutocompleteDefault)
+	}
 }
 
 func inspectExec(cmd *cobra.Command, args []string) error {
+	if registryInspectOpts.RemoteRegistry {
+		return registryInspect(cmd, args)
+	}
+	for _, name := range []string{"authfile", "cert-dir", "creds", "tls-verify"} {
+		if cmd.Flags().Changed(name) {
+			return errors.Errorf("--%s can only be used with --remote-registry", name)
+		}
+	}
 	inspectOpts.Type = inspect.ImageType
 	return inspect.Inspect(args, *inspectOpts)
 }
+
+// registryInspect inspects the images in their registries and prints the
+// descriptors of their manifests and their platforms.
+func registryInspect(cmd *cobra.Command, args []string) error {
+	if len(args) == 0 {
+		return errors.New("no image names specified")
+	}
+	if cmd.Flags().Changed("tls-verify") {
+		registryInspectOpts.SkipTLSVerify = types.NewOptionalBool(!registryInspectOpts.TLSVerifyCLI)
+	}
+	if registryInspectOpts.Authfile != "" {
+		if _, err := os.Stat(registryInspectOpts.Authfile); err != nil {
+			return err
+		}
+	}
+	if registryInspectOpts.CredentialsCLI != "" {
+		creds, err := util.ParseRegistryCreds(registryInspectOpts.CredentialsCLI)
+		if err != nil {
+			return err
+		}
+		registryInspectOpts.Username = creds.Username
+		registryInspectOpts.Password = creds.Password
+	}
+
+	reports := make([]*entities.ImageRegistryInspectReport, 0, len(args))
+	for _, name := range args {
+		r, err := registry.ImageEngine().RegistryInspect(registry.GetContext(), name, registryInspectOpts.ImageRegistryInspectOptions)
+		if err != nil {
+			return err
+		}
+		reports = append(reports, r)
+	}
+
+	if report.IsJSON(inspectOpts.Format) || inspectOpts.Format == "" {
+		buf, err := json.MarshalIndent(reports, "", "    ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Println(string(buf))
+		return err
+	}
+	tmpl, err := template.New("registry inspect").Parse("{{range . }}" + report.NormalizeFormat(inspectOpts.Format) + "{{end}}")
+	if err != nil {
+		return err
+	}
+	return tmpl.Execute(os.Stdout, reports)
+}
@@ -31,7 +31,7 @@ var (
 
 func init() {
 	registry.Commands = append(registry.Commands, registry.CliCommand{
-		Mode:    []entities.EngineMode{entities.ABIMode},
+		Mode:    []entities.EngineMode{entities.ABIMode, entities.TunnelMode},
 		Command: signCommand,
 		Parent:  imageCmd,
 	})
@@ -56,6 +56,10 @@ func sign(cmd *cobra.Command, args []string) error {
 
 	var sigStoreDir string
 	if len(signOptions.Directory) > 0 {
+		// The signatures of podman-remote are stored on the server.
+		if registry.IsRemote() {
+			return errors.New("--directory is not supported with podman-remote")
+		}
 		sigStoreDir = signOptions.Directory
 		if _, err := os.Stat(sigStoreDir); err != nil {
 			return err
@@ -0,0 +1,41 @@
+package manifest
+
+import (
+	"github.com/containers/podman/v2/cmd/podman/common"
+	"github.com/containers/podman/v2/cmd/podman/registry"
+	"github.com/containers/podman/v2/pkg/domain/entities"
+	"github.com/spf13/cobra"
+)
+
+var (
+	existsCmd = &cobra.Command{
+		Use:               "exists LIST",
+		Short:             "Check if a manifest list exists in local storage",
+		Long:              `If the named manifest list exists in local storage, podman manifest exists exits with 0, otherwise the exit code will be 1.`,
+		Args:              cobra.ExactArgs(1),
+		RunE:              exists,
+		ValidArgsFunction: common.AutocompleteImages,
+		Example: `podman manifest exists mylist
+  podman manifest exists mylist || podman manifest create mylist`,
+		DisableFlagsInUseLine: true,
+	}
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Mode:    []entities.EngineMode{entities.ABIMode, entities.TunnelMode},
+		Command: existsCmd,
+		Parent:  manifestCmd,
+	})
+}
+
+func exists(cmd *cobra.Command, args []string) error {
+	found, err := registry.ImageEngine().ManifestExists(registry.GetContext(), args[0])
+	if err != nil {
+		return err
+	}
+	if !found.Value {
+		registry.SetExitCode(1)
+	}
+	return nil
+}
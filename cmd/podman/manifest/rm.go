@@ -0,0 +1,50 @@
+package manifest
+
+import (
+	"fmt"
+
+	"github.com/containers/podman/v2/cmd/podman/common"
+	"github.com/containers/podman/v2/cmd/podman/registry"
+	"github.com/containers/podman/v2/pkg/domain/entities"
+	"github.com/containers/podman/v2/pkg/errorhandling"
+	"github.com/spf13/cobra"
+)
+
+var (
+	rmCmd = &cobra.Command{
+		Use:                   "rm LIST [LIST...]",
+		Short:                 "Remove manifest lists or image indexes from local storage",
+		Long:                  "Removes one or more manifest lists or image indexes from local storage.",
+		RunE:                  rm,
+		ValidArgsFunction:     common.AutocompleteImages,
+		Example:               `podman manifest rm mylist:v1.11`,
+		Args:                  cobra.MinimumNArgs(1),
+		DisableFlagsInUseLine: true,
+	}
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Mode:    []entities.EngineMode{entities.ABIMode, entities.TunnelMode},
+		Command: rmCmd,
+		Parent:  manifestCmd,
+	})
+}
+
+func rm(cmd *cobra.Command, args []string) error {
+	report, rmErrors := registry.ImageEngine().ManifestRm(registry.GetContext(), args)
+	if report != nil {
+		for _, u := range report.Untagged {
+			fmt.Println("Untagged: " + u)
+		}
+		for _, d := range report.Deleted {
+			// Make sure an image was deleted (and not just untagged); else print it
+			if len(d) > 0 {
+				fmt.Println("Deleted: " + d)
+			}
+		}
+		registry.SetExitCode(report.ExitCode)
+	}
+
+	return errorhandling.JoinErrors(rmErrors)
+}
@@ -23,6 +23,7 @@ type playKubeOptionsWrapper struct {
 	TLSVerifyCLI   bool
 	CredentialsCLI string
 	StartCLI       bool
+	Down           bool
 }
 
 var (
@@ -31,7 +32,8 @@ var (
 	kubeOptions        = playKubeOptionsWrapper{}
 	kubeDescription    = `Command reads in a structured file of Kubernetes YAML.
 
-  It creates the pod and containers described in the YAML.  The containers within the pod are then started and the ID of the new Pod is output.`
+  It creates the pod and containers described in the YAML.  The containers within the pod are then started and the ID of the new Pod is output.
+  With --down, the pods, containers and volumes created from the YAML are stopped and removed.`
 
 	kubeCmd = &cobra.Command{
 		Use:               "kube [options] KUBEFILE",
@@ -41,7 +43,8 @@ var (
 		Args:              cobra.ExactArgs(1),
 		ValidArgsFunction: completion.AutocompleteDefault,
 		Example: `podman play kube nginx.yml
-  podman play kube --creds user:password --seccomp-profile-root /custom/path apache.yml`,
+  podman play kube --creds user:password --seccomp-profile-root /custom/path apache.yml
+  podman play kube --down nginx.yml`,
 	}
 )
 
@@ -70,6 +73,7 @@ func init() {
 	flags.BoolVarP(&kubeOptions.Quiet, "quiet", "q", false, "Suppress output information when pulling images")
 	flags.BoolVar(&kubeOptions.TLSVerifyCLI, "tls-verify", true, "Require HTTPS and verify certificates when contacting registries")
 	flags.BoolVar(&kubeOptions.StartCLI, "start", true, "Start the pod after creating it")
+	flags.BoolVar(&kubeOptions.Down, "down", false, "Stop and remove the pods, containers and volumes created from the YAML")
 
 	authfileFlagName := "authfile"
 	flags.StringVar(&kubeOptions.Authfile, authfileFlagName, auth.GetDefaultAuthFile(), "Path of the authentication file. Use REGISTRY_AUTH_FILE environment variable to override")
@@ -95,6 +99,9 @@ func init() {
 }
 
 func kube(cmd *cobra.Command, args []string) error {
+	if kubeOptions.Down {
+		return teardown(args[0])
+	}
 	// TLS verification in c/image is controlled via a `types.OptionalBool`
 	// which allows for distinguishing among set-true, set-false, unspecified
 	// which is important to implement a sane way of dealing with defaults of
@@ -130,6 +137,13 @@ func kube(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	for _, volume := range report.Volumes {
+		fmt.Printf("Volume:\n")
+		fmt.Println(volume.Name)
+		// Empty line for space for next block
+		fmt.Println()
+	}
+
 	for _, pod := range report.Pods {
 		fmt.Printf("Pod:\n")
 		fmt.Println(pod.ID)
@@ -151,3 +165,24 @@ func kube(cmd *cobra.Command, args []string) error {
 
 	return nil
 }
+
+func teardown(yamlfile string) error {
+	report, err := registry.ContainerEngine().PlayKubeDown(registry.GetContext(), yamlfile, entities.PlayKubeDownOptions{})
+	if err != nil {
+		return err
+	}
+
+	if len(report.Pods) > 0 {
+		fmt.Println("Pods removed:")
+		for _, pod := range report.Pods {
+			fmt.Println(pod)
+		}
+	}
+	if len(report.Volumes) > 0 {
+		fmt.Println("Volumes removed:")
+		for _, vol := range report.Volumes {
+			fmt.Println(vol)
+		}
+	}
+	return nil
+}
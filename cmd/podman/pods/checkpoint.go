@@ -0,0 +1,85 @@
+package pods
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/containers/common/pkg/completion"
+	"github.com/containers/podman/v2/cmd/podman/common"
+	"github.com/containers/podman/v2/cmd/podman/registry"
+	"github.com/containers/podman/v2/cmd/podman/utils"
+	"github.com/containers/podman/v2/cmd/podman/validate"
+	"github.com/containers/podman/v2/pkg/domain/entities"
+	"github.com/containers/podman/v2/pkg/rootless"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var (
+	podCheckpointDescription = `The pod name or ID can be used.
+
+  All containers of each specified pod are paused together and then checkpointed.`
+	checkpointCommand = &cobra.Command{
+		Use:   "checkpoint [options] POD [POD...]",
+		Short: "Checkpoint one or more pods",
+		Long:  podCheckpointDescription,
+		RunE:  checkpoint,
+		Args: func(cmd *cobra.Command, args []string) error {
+			return validate.CheckAllLatestAndCIDFile(cmd, args, false, false)
+		},
+		ValidArgsFunction: common.AutocompletePodsRunning,
+		Example: `podman pod checkpoint podID
+  podman pod checkpoint --export /tmp/pod.tar mypod
+  podman pod checkpoint --all`,
+	}
+)
+
+var (
+	checkpointOptions entities.PodCheckpointOptions
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Mode:    []entities.EngineMode{entities.ABIMode},
+		Command: checkpointCommand,
+		Parent:  podCmd,
+	})
+	flags := checkpointCommand.Flags()
+	flags.BoolVarP(&checkpointOptions.Keep, "keep", "k", false, "Keep all temporary checkpoint files")
+	flags.BoolVarP(&checkpointOptions.LeaveRunning, "leave-running", "R", false, "Leave the containers of the pod running after writing the checkpoint to disk")
+	flags.BoolVar(&checkpointOptions.TCPEstablished, "tcp-established", false, "Checkpoint containers with established TCP connections")
+	flags.BoolVarP(&checkpointOptions.All, "all", "a", false, "Checkpoint all running pods")
+
+	exportFlagName := "export"
+	flags.StringVarP(&checkpointOptions.Export, exportFlagName, "e", "", "Export the checkpoint of the pod to a tar archive")
+	_ = checkpointCommand.RegisterFlagCompletionFunc(exportFlagName, completion.AutocompleteDefault)
+
+	flags.BoolVar(&checkpointOptions.IgnoreRootFS, "ignore-rootfs", false, "Do not include root file-system changes when exporting")
+	validate.AddLatestFlag(checkpointCommand, &checkpointOptions.Latest)
+}
+
+func checkpoint(_ *cobra.Command, args []string) error {
+	var errs utils.OutputErrors
+	if rootless.IsRootless() {
+		return errors.New("checkpointing a pod requires root")
+	}
+	if checkpointOptions.Export == "" && checkpointOptions.IgnoreRootFS {
+		return errors.Errorf("--ignore-rootfs can only be used with --export")
+	}
+	if checkpointOptions.Export != "" && (checkpointOptions.All || len(args) > 1) {
+		return errors.Errorf("--export can only be used with a single pod")
+	}
+	responses, err := registry.ContainerEngine().PodCheckpoint(context.Background(), args, checkpointOptions)
+	if err != nil {
+		return err
+	}
+	// in the cli, first we print out all the successful attempts
+	for _, r := range responses {
+		if len(r.Errs) == 0 {
+			fmt.Println(r.Id)
+		} else {
+			errs = append(errs, r.Errs...)
+		}
+	}
+	return errs.PrintErrors()
+}
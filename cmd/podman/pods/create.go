@@ -16,6 +16,7 @@ import (
 	"github.com/containers/podman/v2/pkg/errorhandling"
 	"github.com/containers/podman/v2/pkg/specgen"
 	"github.com/containers/podman/v2/pkg/util"
+	"github.com/docker/go-units"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
@@ -34,12 +35,15 @@ var (
 		Long:              podCreateDescription,
 		RunE:              create,
 		ValidArgsFunction: completion.AutocompleteNone,
+		Example: `podman pod create --name mypod
+  podman pod create --name mypod --cpus 2 --memory 1g --pids-limit 512`,
 	}
 )
 
 var (
 	createOptions     entities.PodCreateOptions
 	labels, labelFile []string
+	memory            string
 	podIDFile         string
 	replace           bool
 	share             string
@@ -60,6 +64,14 @@ func init() {
 	flags.StringVar(&createOptions.CGroupParent, cgroupParentflagName, "", "Set parent cgroup for the pod")
 	_ = createCommand.RegisterFlagCompletionFunc(cgroupParentflagName, completion.AutocompleteDefault)
 
+	cpusFlagName := "cpus"
+	flags.Float64Var(&createOptions.CPUS, cpusFlagName, 0, "Number of CPUs the containers of the pod may use in total. The default is 0.000 which means no limit")
+	_ = createCommand.RegisterFlagCompletionFunc(cpusFlagName, completion.AutocompleteNone)
+
+	cpusetCpusFlagName := "cpuset-cpus"
+	flags.StringVar(&createOptions.CPUSetCPUs, cpusetCpusFlagName, "", "CPUs in which the containers of the pod may execute (0-3, 0,1)")
+	_ = createCommand.RegisterFlagCompletionFunc(cpusetCpusFlagName, completion.AutocompleteNone)
+
 	flags.BoolVar(&createOptions.Infra, "infra", true, "Create an infra container associated with the pod to share namespaces with")
 
 	infraConmonPidfileFlagName := "infra-conmon-pidfile"
@@ -82,6 +94,10 @@ func init() {
 	flags.StringSliceVarP(&labels, labelFlagName, "l", []string{}, "Set metadata on pod (default [])")
 	_ = createCommand.RegisterFlagCompletionFunc(labelFlagName, completion.AutocompleteNone)
 
+	memoryFlagName := "memory"
+	flags.StringVarP(&memory, memoryFlagName, "m", "", "Memory limit of the containers of the pod in total (format: `<number>[<unit>]`, where unit = b (bytes), k (kilobytes), m (megabytes), or g (gigabytes))")
+	_ = createCommand.RegisterFlagCompletionFunc(memoryFlagName, completion.AutocompleteNone)
+
 	nameFlagName := "name"
 	flags.StringVarP(&createOptions.Name, nameFlagName, "n", "", "Assign a name to the pod")
 	_ = createCommand.RegisterFlagCompletionFunc(nameFlagName, completion.AutocompleteNone)
@@ -94,6 +110,10 @@ func init() {
 	flags.StringVar(&podIDFile, podIDFileFlagName, "", "Write the pod ID to the file")
 	_ = createCommand.RegisterFlagCompletionFunc(podIDFileFlagName, completion.AutocompleteDefault)
 
+	pidsLimitFlagName := "pids-limit"
+	flags.Int64Var(&createOptions.PIDsLimit, pidsLimitFlagName, 0, "Maximum number of processes of the containers of the pod in total (0 means no limit)")
+	_ = createCommand.RegisterFlagCompletionFunc(pidsLimitFlagName, completion.AutocompleteNone)
+
 	flags.BoolVar(&replace, "replace", false, "If a pod with the same name exists, replace it")
 
 	shareFlagName := "share"
@@ -155,6 +175,19 @@ func create(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	if createOptions.CPUS < 0 {
+		return errors.New("--cpus must not be negative")
+	}
+	if createOptions.PIDsLimit < 0 {
+		return errors.New("--pids-limit must not be negative")
+	}
+	if memory != "" {
+		createOptions.Memory, err = units.RAMInBytes(memory)
+		if err != nil {
+			return errors.Wrapf(err, "invalid value for memory")
+		}
+	}
+
 	if cmd.Flag("pod-id-file").Changed {
 		podIDFD, err = util.OpenExclusiveFile(podIDFile)
 		if err != nil && os.IsExist(err) {
@@ -8,6 +8,7 @@ import (
 	"net/url"
 	"os"
 	"os/user"
+	"path/filepath"
 	"regexp"
 
 	"github.com/containers/common/pkg/completion"
@@ -33,13 +34,15 @@ var (
 		Short: "Record destination for the Podman service",
 		Long: `Add destination to podman configuration.
   "destination" is of the form [user@]hostname or
-  an URI of the form ssh://[user@]hostname[:port]
+  an URI of the form ssh://[user@]hostname[:port],
+  tcp://hostname:port or tls://hostname:port
 `,
 		RunE:              add,
 		ValidArgsFunction: completion.AutocompleteNone,
 		Example: `podman system connection add laptop server.fubar.com
   podman system connection add --identity ~/.ssh/dev_rsa testing ssh://root@server.fubar.com:2222
   podman system connection add --identity ~/.ssh/dev_rsa --port 22 production root@server.fubar.com
+  podman system connection add --tls-ca ca.pem --tls-cert cert.pem --tls-key key.pem internal tls://server.fubar.com:8443
   `,
 	}
 
@@ -48,6 +51,9 @@ var (
 		Port     int
 		UDSPath  string
 		Default  bool
+		TLSCA    string
+		TLSCert  string
+		TLSKey   string
 	}{}
 )
 
@@ -73,6 +79,18 @@ func init() {
 	_ = addCmd.RegisterFlagCompletionFunc(socketPathFlagName, completion.AutocompleteDefault)
 
 	flags.BoolVarP(&cOpts.Default, "default", "d", false, "Set connection to be default")
+
+	tlsCAFlagName := "tls-ca"
+	flags.StringVar(&cOpts.TLSCA, tlsCAFlagName, "", "path to PEM encoded CA certificates to verify the TLS destination with")
+	_ = addCmd.RegisterFlagCompletionFunc(tlsCAFlagName, completion.AutocompleteDefault)
+
+	tlsCertFlagName := "tls-cert"
+	flags.StringVar(&cOpts.TLSCert, tlsCertFlagName, "", "path to PEM encoded client certificate for mutual TLS")
+	_ = addCmd.RegisterFlagCompletionFunc(tlsCertFlagName, completion.AutocompleteDefault)
+
+	tlsKeyFlagName := "tls-key"
+	flags.StringVar(&cOpts.TLSKey, tlsKeyFlagName, "", "path to PEM encoded client key for mutual TLS")
+	_ = addCmd.RegisterFlagCompletionFunc(tlsKeyFlagName, completion.AutocompleteDefault)
 }
 
 func add(cmd *cobra.Command, args []string) error {
@@ -89,28 +107,14 @@ func add(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	if uri.User.Username() == "" {
-		if uri.User, err = getUserInfo(uri); err != nil {
-			return err
-		}
-	}
-
-	if cmd.Flags().Changed("socket-path") {
-		uri.Path = cmd.Flag("socket-path").Value.String()
+	switch uri.Scheme {
+	case "tcp", "tls":
+		err = tcpDestination(cmd, uri)
+	default:
+		err = sshDestination(cmd, uri)
 	}
-
-	if cmd.Flags().Changed("port") {
-		uri.Host = net.JoinHostPort(uri.Hostname(), cmd.Flag("port").Value.String())
-	}
-
-	if uri.Port() == "" {
-		uri.Host = net.JoinHostPort(uri.Hostname(), cmd.Flag("port").DefValue)
-	}
-
-	if uri.Path == "" || uri.Path == "/" {
-		if uri.Path, err = getUDS(cmd, uri); err != nil {
-			return err
-		}
+	if err != nil {
+		return err
 	}
 
 	cfg, err := config.ReadCustomConfig()
@@ -143,6 +147,72 @@ func add(cmd *cobra.Command, args []string) error {
 	return cfg.Write()
 }
 
+// sshDestination completes the user, port and socket path of an ssh
+// destination, looking up the socket path on the host if not given.
+func sshDestination(cmd *cobra.Command, uri *url.URL) error {
+	var err error
+	if uri.User.Username() == "" {
+		if uri.User, err = getUserInfo(uri); err != nil {
+			return err
+		}
+	}
+
+	if cmd.Flags().Changed("socket-path") {
+		uri.Path = cmd.Flag("socket-path").Value.String()
+	}
+
+	if cmd.Flags().Changed("port") {
+		uri.Host = net.JoinHostPort(uri.Hostname(), cmd.Flag("port").Value.String())
+	}
+
+	if uri.Port() == "" {
+		uri.Host = net.JoinHostPort(uri.Hostname(), cmd.Flag("port").DefValue)
+	}
+
+	// The fingerprint of the host key is recorded when connecting to the
+	// host, all later connections must present the same key.
+	if uri.Path == "" || uri.Path == "/" {
+		var fingerprint string
+		if uri.Path, fingerprint, err = getUDS(cmd, uri); err != nil {
+			return err
+		}
+		query := uri.Query()
+		query.Set("fingerprint", fingerprint)
+		uri.RawQuery = query.Encode()
+	}
+	return nil
+}
+
+// tcpDestination records the absolute paths of the TLS files given on the
+// command line in the query of a tcp or tls destination. A tcp destination
+// becomes a tls one if any of them is given.
+func tcpDestination(cmd *cobra.Command, uri *url.URL) error {
+	if cmd.Flags().Changed("port") {
+		uri.Host = net.JoinHostPort(uri.Hostname(), cmd.Flag("port").Value.String())
+	}
+	if uri.Port() == "" {
+		return errors.Errorf("%s destination %q requires a port", uri.Scheme, uri.String())
+	}
+
+	query := uri.Query()
+	for flagName, key := range map[string]string{"tls-ca": "ca", "tls-cert": "cert", "tls-key": "key"} {
+		if !cmd.Flags().Changed(flagName) {
+			continue
+		}
+		path, err := filepath.Abs(cmd.Flag(flagName).Value.String())
+		if err != nil {
+			return err
+		}
+		query.Set(key, path)
+		uri.Scheme = "tls"
+	}
+	if (query.Get("cert") == "") != (query.Get("key") == "") {
+		return errors.New("a client certificate and key must be given together")
+	}
+	uri.RawQuery = query.Encode()
+	return nil
+}
+
 func getUserInfo(uri *url.URL) (*url.Userinfo, error) {
 	var (
 		usr *user.User
@@ -167,8 +237,37 @@ func getUserInfo(uri *url.URL) (*url.Userinfo, error) {
 	return url.User(usr.Username), nil
 }
 
-func getUDS(cmd *cobra.Command, uri *url.URL) (string, error) {
-	var authMethods []ssh.AuthMethod
+// trustHostKey returns a callback verifying the key of a ssh host the same
+// way as the remote client, except that the user is asked whether to trust
+// the key of an unknown host. The fingerprint of the accepted key is stored
+// in fingerprint.
+func trustHostKey(expected string, fingerprint *string) ssh.HostKeyCallback {
+	verify := terminal.HostKeyCallback(expected)
+	return func(hostname string, remote net.Addr, key ssh.PublicKey) error {
+		if err := verify(hostname, remote, key); err != nil {
+			if errors.Cause(err) != terminal.ErrUnknownHostKey {
+				return err
+			}
+			trusted, confirmErr := terminal.ConfirmHostKey(hostname, key)
+			if confirmErr != nil {
+				return confirmErr
+			}
+			if !trusted {
+				return err
+			}
+		}
+		*fingerprint = ssh.FingerprintSHA256(key)
+		return nil
+	}
+}
+
+// getUDS returns the path of the Podman socket on the ssh destination and the
+// fingerprint of the host key.
+func getUDS(cmd *cobra.Command, uri *url.URL) (string, string, error) {
+	var (
+		authMethods []ssh.AuthMethod
+		fingerprint string
+	)
 	passwd, set := uri.User.Password()
 	if set {
 		authMethods = append(authMethods, ssh.Password(passwd))
@@ -178,7 +277,7 @@ func getUDS(cmd *cobra.Command, uri *url.URL) (string, error) {
 		value := cmd.Flag("identity").Value.String()
 		auth, err := terminal.PublicKey(value, []byte(passwd))
 		if err != nil {
-			return "", errors.Wrapf(err, "failed to read identity %q", value)
+			return "", "", errors.Wrapf(err, "failed to read identity %q", value)
 		}
 		authMethods = append(authMethods, auth)
 	}
@@ -188,7 +287,7 @@ func getUDS(cmd *cobra.Command, uri *url.URL) (string, error) {
 
 		c, err := net.Dial("unix", sock)
 		if err != nil {
-			return "", err
+			return "", "", err
 		}
 		a := agent.NewClient(c)
 		authMethods = append(authMethods, ssh.PublicKeysCallback(a.Signers))
@@ -197,7 +296,7 @@ func getUDS(cmd *cobra.Command, uri *url.URL) (string, error) {
 	if len(authMethods) == 0 {
 		pass, err := terminal.ReadPassword(fmt.Sprintf("%s's login password:", uri.User.Username()))
 		if err != nil {
-			return "", err
+			return "", "", err
 		}
 		authMethods = append(authMethods, ssh.Password(string(pass)))
 	}
@@ -205,17 +304,17 @@ func getUDS(cmd *cobra.Command, uri *url.URL) (string, error) {
 	cfg := &ssh.ClientConfig{
 		User:            uri.User.Username(),
 		Auth:            authMethods,
-		HostKeyCallback: ssh.InsecureIgnoreHostKey(),
+		HostKeyCallback: trustHostKey(uri.Query().Get("fingerprint"), &fingerprint),
 	}
 	dial, err := ssh.Dial("tcp", uri.Host, cfg)
 	if err != nil {
-		return "", errors.Wrapf(err, "failed to connect")
+		return "", "", errors.Wrapf(err, "failed to connect")
 	}
 	defer dial.Close()
 
 	session, err := dial.NewSession()
 	if err != nil {
-		return "", errors.Wrapf(err, "failed to create new ssh session on %q", uri.Host)
+		return "", "", errors.Wrapf(err, "failed to create new ssh session on %q", uri.Host)
 	}
 	defer session.Close()
 
@@ -229,16 +328,16 @@ func getUDS(cmd *cobra.Command, uri *url.URL) (string, error) {
 	var buffer bytes.Buffer
 	session.Stdout = &buffer
 	if err := session.Run(run); err != nil {
-		return "", err
+		return "", "", err
 	}
 
 	var info define.Info
 	if err := json.Unmarshal(buffer.Bytes(), &info); err != nil {
-		return "", errors.Wrapf(err, "failed to parse 'podman info' results")
+		return "", "", errors.Wrapf(err, "failed to parse 'podman info' results")
 	}
 
 	if info.Host.RemoteSocket == nil || len(info.Host.RemoteSocket.Path) == 0 {
-		return "", errors.Errorf("remote podman %q failed to report its UDS socket", uri.Host)
+		return "", "", errors.Errorf("remote podman %q failed to report its UDS socket", uri.Host)
 	}
-	return info.Host.RemoteSocket.Path, nil
+	return info.Host.RemoteSocket.Path, fingerprint, nil
 }
@@ -15,6 +15,7 @@ import (
 	"github.com/containers/podman/v2/pkg/rootless"
 	"github.com/containers/podman/v2/pkg/systemd"
 	"github.com/containers/podman/v2/pkg/util"
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -37,8 +38,11 @@ Enable a listening service for API access to Podman commands.
 	}
 
 	srvArgs = struct {
-		Timeout int64
-		Varlink bool
+		Timeout     int64
+		Varlink     bool
+		TLSCert     string
+		TLSKey      string
+		TLSClientCA string
 	}{}
 )
 
@@ -57,6 +61,18 @@ func init() {
 
 	flags.BoolVar(&srvArgs.Varlink, "varlink", false, "Use legacy varlink service instead of REST. Unit of --time changes from seconds to milliseconds.")
 
+	tlsCertFlagName := "tls-cert"
+	flags.StringVar(&srvArgs.TLSCert, tlsCertFlagName, "", "PEM encoded certificate of the TLS server (tcp endpoints only)")
+	_ = srvCmd.RegisterFlagCompletionFunc(tlsCertFlagName, completion.AutocompleteDefault)
+
+	tlsKeyFlagName := "tls-key"
+	flags.StringVar(&srvArgs.TLSKey, tlsKeyFlagName, "", "PEM encoded private key of the TLS server")
+	_ = srvCmd.RegisterFlagCompletionFunc(tlsKeyFlagName, completion.AutocompleteDefault)
+
+	tlsClientCAFlagName := "tls-client-ca"
+	flags.StringVar(&srvArgs.TLSClientCA, tlsClientCAFlagName, "", "Require client certificates signed by the PEM encoded CA certificates (mutual TLS)")
+	_ = srvCmd.RegisterFlagCompletionFunc(tlsClientCAFlagName, completion.AutocompleteDefault)
+
 	_ = flags.MarkDeprecated("varlink", "valink API is deprecated.")
 	flags.SetNormalizeFunc(aliasTimeoutFlag)
 }
@@ -93,11 +109,17 @@ func service(cmd *cobra.Command, args []string) error {
 	}
 
 	opts := entities.ServiceOptions{
-		URI:     apiURI,
-		Command: cmd,
+		URI:             apiURI,
+		Command:         cmd,
+		TLSCertFile:     srvArgs.TLSCert,
+		TLSKeyFile:      srvArgs.TLSKey,
+		TLSClientCAFile: srvArgs.TLSClientCA,
 	}
 
 	if srvArgs.Varlink {
+		if opts.TLSCertFile != "" || opts.TLSKeyFile != "" || opts.TLSClientCAFile != "" {
+			return errors.New("TLS is not supported by the varlink service")
+		}
 		opts.Timeout = time.Duration(srvArgs.Timeout) * time.Millisecond
 		return registry.ContainerEngine().VarlinkService(registry.GetContext(), opts)
 	}
@@ -21,17 +21,42 @@ func restService(opts entities.ServiceOptions, flags *pflag.FlagSet, cfg *entiti
 		err      error
 	)
 
+	useTLS := opts.TLSCertFile != "" || opts.TLSKeyFile != "" || opts.TLSClientCAFile != ""
 	if opts.URI != "" {
 		fields := strings.Split(opts.URI, ":")
 		if len(fields) == 1 {
 			return errors.Errorf("%s is an invalid socket destination", opts.URI)
 		}
 		address := strings.Join(fields[1:], ":")
+		if fields[0] == "tcp" {
+			// Accept tcp://host:port as well as tcp:host:port.
+			address = strings.TrimPrefix(address, "//")
+		}
+		if useTLS && fields[0] != "tcp" {
+			return errors.Errorf("TLS is only supported for tcp endpoints, not %s", opts.URI)
+		}
 		l, err := net.Listen(fields[0], address)
 		if err != nil {
 			return errors.Wrapf(err, "unable to create socket")
 		}
+		if useTLS {
+			tlsOptions := api.TLSOptions{
+				CertFile:     opts.TLSCertFile,
+				KeyFile:      opts.TLSKeyFile,
+				ClientCAFile: opts.TLSClientCAFile,
+			}
+			tlsListener, err := api.NewTLSListener(l, tlsOptions)
+			if err != nil {
+				_ = l.Close()
+				return err
+			}
+			l = tlsListener
+		} else if fields[0] == "tcp" {
+			logrus.Warnf("Serving the API on %s without TLS: anyone who can reach it has full access to Podman", opts.URI)
+		}
 		listener = &l
+	} else if useTLS {
+		return errors.New("TLS requires a tcp endpoint")
 	}
 
 	rt, err := infra.GetRuntime(context.Background(), flags, cfg)
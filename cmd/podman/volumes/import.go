@@ -0,0 +1,47 @@
+package volumes
+
+import (
+	"context"
+
+	"github.com/containers/podman/v2/cmd/podman/common"
+	"github.com/containers/podman/v2/cmd/podman/parse"
+	"github.com/containers/podman/v2/cmd/podman/registry"
+	"github.com/containers/podman/v2/pkg/domain/entities"
+	"github.com/spf13/cobra"
+)
+
+var (
+	volumeImportDescription = `Import the contents of a tar archive into a volume.
+
+  The archive may be compressed. Use "-" as source to read the archive from stdin. Files in the volume are replaced by the files of the archive with the same path, all other files are kept.`
+	importCommand = &cobra.Command{
+		Use:               "import VOLUME SOURCE",
+		Short:             "Import a tar archive into a volume",
+		Long:              volumeImportDescription,
+		RunE:              importVolume,
+		Args:              cobra.ExactArgs(2),
+		ValidArgsFunction: common.AutocompleteVolumeImportCmd,
+		Example: `podman volume import myvol myvol.tar
+  cat myvol.tar.gz | podman volume import myvol -`,
+	}
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Mode:    []entities.EngineMode{entities.ABIMode, entities.TunnelMode},
+		Command: importCommand,
+		Parent:  volumeCmd,
+	})
+}
+
+func importVolume(cmd *cobra.Command, args []string) error {
+	options := entities.VolumeImportOptions{
+		Input: args[1],
+	}
+	if options.Input == "-" {
+		options.Input = "/dev/stdin"
+	} else if err := parse.ValidateFileName(options.Input); err != nil {
+		return err
+	}
+	return registry.ContainerEngine().VolumeImport(context.Background(), args[0], options)
+}
@@ -1682,6 +1682,133 @@ func (s *BoltState) RewriteContainerConfig(ctr *Container, newCfg *ContainerConf
 	return err
 }
 
+// SafeRewriteContainerConfig rewrites a container's configuration, changing
+// its name or its resource limits. The name registries and all references to
+// the name of the container are updated in a single transaction.
+// Please read the full comment on this function in state.go before using it.
+func (s *BoltState) SafeRewriteContainerConfig(ctr *Container, oldName, newName string, newCfg *ContainerConfig) error {
+	if !s.valid {
+		return define.ErrDBClosed
+	}
+
+	if !ctr.valid {
+		return define.ErrCtrRemoved
+	}
+
+	if newName == "" || newCfg.Name != newName {
+		return errors.Wrapf(define.ErrInvalidArg, "new name %q for container %s must match the name in the new configuration", newName, ctr.ID())
+	}
+
+	newCfgJSON, err := json.Marshal(newCfg)
+	if err != nil {
+		return errors.Wrapf(err, "error marshalling new configuration JSON for container %s", ctr.ID())
+	}
+
+	ctrID := []byte(ctr.ID())
+	ctrName := []byte(newName)
+
+	db, err := s.getDBCon()
+	if err != nil {
+		return err
+	}
+	defer s.deferredCloseDBCon(db)
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		idsBkt, err := getIDBucket(tx)
+		if err != nil {
+			return err
+		}
+
+		namesBkt, err := getNamesBucket(tx)
+		if err != nil {
+			return err
+		}
+
+		ctrBkt, err := getCtrBucket(tx)
+		if err != nil {
+			return err
+		}
+
+		allCtrsBkt, err := getAllCtrsBucket(tx)
+		if err != nil {
+			return err
+		}
+
+		ctrDB := ctrBkt.Bucket(ctrID)
+		if ctrDB == nil {
+			ctr.valid = false
+			return errors.Wrapf(define.ErrNoSuchCtr, "no container with ID %s found in DB", ctr.ID())
+		}
+
+		if oldName != newName {
+			// Make sure the new name is not in use by another
+			// container or pod
+			if nameExist := namesBkt.Get(ctrName); nameExist != nil {
+				err := define.ErrCtrExists
+				if allCtrsBkt.Get(nameExist) == nil {
+					err = define.ErrPodExists
+				}
+				return errors.Wrapf(err, "name %q is in use", newName)
+			}
+
+			if err := namesBkt.Delete([]byte(oldName)); err != nil {
+				return errors.Wrapf(err, "error removing container %s old name %q from DB", ctr.ID(), oldName)
+			}
+			if err := namesBkt.Put(ctrName, ctrID); err != nil {
+				return errors.Wrapf(err, "error adding container %s name %q to DB", ctr.ID(), newName)
+			}
+			if err := idsBkt.Put(ctrID, ctrName); err != nil {
+				return errors.Wrapf(err, "error updating container %s name in ID registry", ctr.ID())
+			}
+			if err := allCtrsBkt.Put(ctrID, ctrName); err != nil {
+				return errors.Wrapf(err, "error updating container %s name in all containers bucket", ctr.ID())
+			}
+
+			// Update the name in the pod's container bucket
+			if podID := ctrDB.Get(podIDKey); podID != nil {
+				podBkt, err := getPodBucket(tx)
+				if err != nil {
+					return err
+				}
+				podDB := podBkt.Bucket(podID)
+				if podDB == nil {
+					return errors.Wrapf(define.ErrNoSuchPod, "pod %s of container %s not found in DB", string(podID), ctr.ID())
+				}
+				podCtrs := podDB.Bucket(containersBkt)
+				if podCtrs == nil {
+					return errors.Wrapf(define.ErrInternal, "pod %s does not have a containers bucket", string(podID))
+				}
+				if err := podCtrs.Put(ctrID, ctrName); err != nil {
+					return errors.Wrapf(err, "error updating container %s name in pod %s", ctr.ID(), string(podID))
+				}
+			}
+
+			// Update the name in the dependencies buckets of the
+			// containers this container depends on
+			for _, dependsCtr := range ctr.Dependencies() {
+				depCtrBkt := ctrBkt.Bucket([]byte(dependsCtr))
+				if depCtrBkt == nil {
+					continue
+				}
+				depCtrDependsBkt := depCtrBkt.Bucket(dependenciesBkt)
+				if depCtrDependsBkt == nil || depCtrDependsBkt.Get(ctrID) == nil {
+					continue
+				}
+				if err := depCtrDependsBkt.Put(ctrID, ctrName); err != nil {
+					return errors.Wrapf(err, "error updating container %s name in dependencies of container %s", ctr.ID(), dependsCtr)
+				}
+			}
+		}
+
+		if err := ctrDB.Put(configKey, newCfgJSON); err != nil {
+			return errors.Wrapf(err, "error updating container %s config JSON", ctr.ID())
+		}
+
+		return nil
+	})
+	return err
+}
+
 // RewritePodConfig rewrites a pod's configuration.
 // WARNING: This function is DANGEROUS. Do not use without reading the full
 // comment on this function in state.go.
@@ -14,6 +14,7 @@ import (
 	"github.com/containers/podman/v2/libpod/define"
 	"github.com/containers/podman/v2/libpod/lock"
 	"github.com/containers/podman/v2/pkg/rootless"
+	"github.com/containers/podman/v2/pkg/secrets"
 	"github.com/containers/storage"
 	"github.com/cri-o/ocicni/pkg/ocicni"
 	spec "github.com/opencontainers/runtime-spec/specs-go"
@@ -203,6 +204,15 @@ type ContainerState struct {
 	// restart policy. This is NOT incremented by normal container restarts
 	// (only by restart policy).
 	RestartCount uint `json:"restartCount,omitempty"`
+	// StartupHCPassed indicates that the startup healthcheck has succeeded
+	// and the regular healthcheck has taken over.
+	StartupHCPassed bool `json:"startupHCPassed,omitempty"`
+	// StartupHCFailureCount is the number of consecutive failed startup
+	// healthchecks.
+	StartupHCFailureCount int `json:"startupHCFailureCount,omitempty"`
+	// HCUnitName is the name of the systemd transient unit running the
+	// healthchecks of the container.
+	HCUnitName string `json:"hcUnitName,omitempty"`
 
 	// ExtensionStageHooks holds hooks which will be executed by libpod
 	// and not delegated to the OCI runtime.
@@ -508,6 +518,23 @@ func (c *Container) Command() []string {
 	return command
 }
 
+// Secrets returns the secrets mounted into the container
+func (c *Container) Secrets() []*secrets.Secret {
+	ctrSecrets := make([]*secrets.Secret, 0, len(c.config.Secrets))
+	ctrSecrets = append(ctrSecrets, c.config.Secrets...)
+	return ctrSecrets
+}
+
+// EnvSecrets returns the secrets exposed to the container as environment
+// variables, keyed by variable name
+func (c *Container) EnvSecrets() map[string]*secrets.Secret {
+	envSecrets := make(map[string]*secrets.Secret)
+	for key, value := range c.config.EnvSecrets {
+		envSecrets[key] = value
+	}
+	return envSecrets
+}
+
 // Stdin returns whether STDIN on the container will be kept open
 func (c *Container) Stdin() bool {
 	return c.config.Stdin
@@ -1071,6 +1098,19 @@ func (c *Container) HealthCheckConfig() *manifest.Schema2HealthConfig {
 	return c.config.HealthCheckConfig
 }
 
+// StartupHealthCheckConfig returns the startup healthcheck of the container,
+// which runs until it succeeds once before the regular healthcheck takes
+// over.
+func (c *Container) StartupHealthCheckConfig() *manifest.Schema2HealthConfig {
+	return c.config.StartupHealthCheckConfig
+}
+
+// HealthCheckOnFailureAction returns the action taken once the container
+// turns unhealthy.
+func (c *Container) HealthCheckOnFailureAction() define.HealthCheckOnFailureAction {
+	return c.config.HealthCheckOnFailureAction
+}
+
 // AutoRemove indicates whether the container will be removed after it is executed
 func (c *Container) AutoRemove() bool {
 	spec := c.config.Spec
@@ -10,6 +10,7 @@ import (
 	"github.com/containers/podman/v2/libpod/define"
 	"github.com/containers/podman/v2/libpod/events"
 	"github.com/containers/podman/v2/pkg/signal"
+	spec "github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/opentracing/opentracing-go"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -424,6 +425,27 @@ func (c *Container) Unpause() error {
 	return c.unpause()
 }
 
+// Update updates the resource limits of a container. Only the limits set in
+// resources are changed. The limits are stored in the container's
+// configuration and, if the container is running or paused, applied to it
+// immediately.
+func (c *Container) Update(resources *spec.LinuxResources) error {
+	if !c.batched {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+
+		if err := c.syncContainer(); err != nil {
+			return err
+		}
+	}
+
+	if c.state.State == define.ContainerStateRemoving {
+		return errors.Wrapf(define.ErrCtrStateInvalid, "cannot update container %s as it is being removed", c.ID())
+	}
+	defer c.newContainerEvent(events.Update)
+	return c.update(resources)
+}
+
 // Export exports a container's root filesystem as a tar archive
 // The archive will be saved as a file at the given path
 func (c *Container) Export(path string) error {
@@ -677,13 +699,27 @@ type ContainerCheckpointOptions struct {
 	// important to be able to restore a container multiple
 	// times with '--import --name'.
 	IgnoreStaticMAC bool
+	// PreCheckPoint tells the API to only dump the memory pages of the
+	// container while it keeps running. Repeated pre-checkpoints with
+	// WithPrevious only dump the pages changed since the last one.
+	PreCheckPoint bool
+	// WithPrevious tells the API to base the checkpoint (or
+	// pre-checkpoint) on the last pre-checkpoint of the container
+	WithPrevious bool
+	// CreateImage tells the API to commit the checkpoint to an image
+	// with the name set in CreateImage, so that it can be pushed to a
+	// registry
+	CreateImage string
+	// pod tells the API that the container is checkpointed (or
+	// restored) together with all other containers of its pod
+	pod bool
 }
 
 // Checkpoint checkpoints a container
 func (c *Container) Checkpoint(ctx context.Context, options ContainerCheckpointOptions) error {
 	logrus.Debugf("Trying to checkpoint container %s", c.ID())
 
-	if options.TargetFile != "" {
+	if options.TargetFile != "" || options.CreateImage != "" {
 		if err := c.prepareCheckpointExport(); err != nil {
 			return err
 		}
@@ -5,7 +5,9 @@ import (
 	"time"
 
 	"github.com/containers/image/v5/manifest"
+	"github.com/containers/podman/v2/libpod/define"
 	"github.com/containers/podman/v2/pkg/namespaces"
+	"github.com/containers/podman/v2/pkg/secrets"
 	"github.com/containers/storage"
 	"github.com/cri-o/ocicni/pkg/ocicni"
 	spec "github.com/opencontainers/runtime-spec/specs-go"
@@ -140,6 +142,9 @@ type ContainerRootFSConfig struct {
 	// working directory if it does not exist. Some OCI runtimes do this by
 	// default, but others do not.
 	CreateWorkingDir bool `json:"createWorkingDir,omitempty"`
+	// Secrets are the secrets mounted in the container at
+	// /run/secrets/<name>.
+	Secrets []*secrets.Secret `json:"secrets,omitempty"`
 }
 
 // ContainerSecurityConfig is an embedded sub-config providing security configuration
@@ -178,6 +183,11 @@ type ContainerSecurityConfig struct {
 	// Libpod - mostly used in rootless containers where the user running
 	// Libpod wants to retain their UID inside the container.
 	AddCurrentUserPasswdEntry bool `json:"addCurrentUserPasswdEntry,omitempty"`
+	// EnvSecrets are secrets exposed to the container as environment
+	// variables, keyed by the name of the variable. The secret data is
+	// only added to the spec given to the OCI runtime, and is not part of
+	// the container's stored spec.
+	EnvSecrets map[string]*secrets.Secret `json:"secret_env,omitempty"`
 }
 
 // ContainerNameSpaceConfig is an embedded sub-config providing
@@ -306,6 +316,12 @@ type ContainerMiscConfig struct {
 	LogTag string `json:"logTag"`
 	// LogSize is the tag used for logging
 	LogSize int64 `json:"logSize"`
+	// LogMaxFiles is the number of log files kept when the log file is
+	// rotated once it reaches LogSize, including the current one. Values
+	// below 2 disable rotation; the log file is truncated instead.
+	LogMaxFiles uint `json:"logMaxFiles,omitempty"`
+	// LogCompress indicates whether rotated log files are compressed.
+	LogCompress bool `json:"logCompress,omitempty"`
 	// LogDriver driver for logs
 	LogDriver string `json:"logDriver"`
 	// File containing the conmon PID
@@ -344,6 +360,19 @@ type ContainerMiscConfig struct {
 	Systemd bool `json:"systemd"`
 	// HealthCheckConfig has the health check command and related timings
 	HealthCheckConfig *manifest.Schema2HealthConfig `json:"healthcheck"`
+	// HealthCheckOnFailureAction defines an action to take once the container
+	// turns unhealthy.
+	HealthCheckOnFailureAction define.HealthCheckOnFailureAction `json:"healthcheck_on_failure_action"`
+	// StartupHealthCheckConfig is a healthcheck that runs until it succeeds
+	// once, after which the regular healthcheck takes over.
+	StartupHealthCheckConfig *manifest.Schema2HealthConfig `json:"startupHealthCheck,omitempty"`
+	// HealthMaxLogCount is the number of healthcheck results kept in the
+	// healthcheck log. 0 uses the default of MaxHealthCheckNumberLogs.
+	HealthMaxLogCount uint `json:"healthMaxLogCount,omitempty"`
+	// HealthMaxLogSize is the maximum number of characters of the output
+	// of a healthcheck kept in the healthcheck log. 0 uses the default of
+	// MaxHealthCheckLogLength.
+	HealthMaxLogSize uint `json:"healthMaxLogSize,omitempty"`
 	// PreserveFDs is a number of additional file descriptors (in addition
 	// to 0, 1, 2) that will be passed to the executed process. The total FDs
 	// passed will be 3 + PreserveFDs.
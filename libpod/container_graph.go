@@ -2,6 +2,7 @@ package libpod
 
 import (
 	"context"
+	"sort"
 	"strings"
 
 	"github.com/containers/podman/v2/libpod/define"
@@ -37,6 +38,35 @@ func (cg *ContainerGraph) DependencyMap() (dependencies map[*Container][]*Contai
 	return dependencies
 }
 
+// dependencyOrder returns the containers of the graph ordered so that every
+// container comes after all containers it depends on.
+func (cg *ContainerGraph) dependencyOrder() []*Container {
+	ids := make([]string, 0, len(cg.nodes))
+	for id := range cg.nodes {
+		ids = append(ids, id)
+	}
+	// Sort the IDs to always return the same order for the same graph
+	sort.Strings(ids)
+
+	ordered := make([]*Container, 0, len(cg.nodes))
+	visited := make(map[string]bool)
+	var visit func(node *containerNode)
+	visit = func(node *containerNode) {
+		if visited[node.id] {
+			return
+		}
+		visited[node.id] = true
+		for _, dep := range node.dependsOn {
+			visit(dep)
+		}
+		ordered = append(ordered, node.container)
+	}
+	for _, id := range ids {
+		visit(cg.nodes[id])
+	}
+	return ordered
+}
+
 // BuildContainerGraph builds a dependency graph based on the container slice.
 func BuildContainerGraph(ctrs []*Container) (*ContainerGraph, error) {
 	graph := new(ContainerGraph)
@@ -2,6 +2,7 @@ package libpod
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/containers/common/pkg/config"
@@ -213,7 +214,11 @@ func (c *Container) getInspectMounts(namedVolumes []*ContainerNamedVolume, image
 			return nil, errors.Wrapf(err, "error looking up volume %s in container %s config", volume.Name, c.ID())
 		}
 		mountStruct.Driver = volFromDB.Driver()
-		mountStruct.Source = volFromDB.MountPoint()
+		mountPoint, err := volFromDB.MountPoint()
+		if err != nil {
+			return nil, err
+		}
+		mountStruct.Source = mountPoint
 
 		parseMountOptionsForInspect(volume.Options, &mountStruct)
 
@@ -331,6 +336,12 @@ func (c *Container) generateInspectContainerConfig(spec *spec.Spec) *define.Insp
 	// TODO: should JSON deep copy this to ensure internal pointers don't
 	// leak.
 	ctrConfig.Healthcheck = c.config.HealthCheckConfig
+	if c.config.HealthCheckConfig != nil {
+		ctrConfig.HealthcheckOnFailureAction = c.config.HealthCheckOnFailureAction.String()
+		ctrConfig.StartupHealthCheck = c.config.StartupHealthCheckConfig
+		ctrConfig.HealthMaxLogCount = uint(c.healthCheckMaxLogCount())
+		ctrConfig.HealthMaxLogSize = uint(c.healthCheckMaxLogSize())
+	}
 
 	ctrConfig.CreateCommand = c.config.CreateCommand
 
@@ -354,6 +365,13 @@ func (c *Container) generateInspectContainerHostConfig(ctrSpec *spec.Spec, named
 
 	logConfig := new(define.InspectLogConfig)
 	logConfig.Type = c.config.LogDriver
+	if c.config.LogMaxFiles > 1 {
+		logConfig.Config = map[string]string{
+			"max-size": strconv.FormatInt(c.config.LogSize, 10),
+			"max-file": strconv.FormatUint(uint64(c.config.LogMaxFiles), 10),
+			"compress": strconv.FormatBool(c.config.LogCompress),
+		}
+	}
 	hostConfig.LogConfig = logConfig
 
 	restartPolicy := new(define.InspectRestartPolicy)
@@ -134,6 +134,12 @@ func (c *Container) CheckpointPath() string {
 	return filepath.Join(c.bundlePath(), "checkpoint")
 }
 
+// PreCheckPointPath returns the path to the directory containing the
+// pre-checkpoints of the container
+func (c *Container) PreCheckPointPath() string {
+	return filepath.Join(c.bundlePath(), "pre-checkpoint")
+}
+
 // AttachSocketPath retrieves the path of the container's attach socket
 func (c *Container) AttachSocketPath() (string, error) {
 	return c.ociRuntime.AttachSocketPath(c)
@@ -320,6 +326,10 @@ func (c *Container) syncContainer() error {
 			return err
 		}
 
+		if err := c.rotateLog(); err != nil {
+			logrus.Errorf("Error rotating log of container %s: %v", c.ID(), err)
+		}
+
 		// Only save back to DB if state changed
 		if c.state.State != oldState {
 			// Check for a restart policy match
@@ -1035,6 +1045,10 @@ func (c *Container) init(ctx context.Context, retainRetries bool) error {
 		return err
 	}
 
+	if err := c.rotateLog(); err != nil {
+		logrus.Errorf("Error rotating log of container %s: %v", c.ID(), err)
+	}
+
 	// Generate the OCI newSpec
 	newSpec, err := c.generateSpec(ctx)
 	if err != nil {
@@ -1080,18 +1094,21 @@ func (c *Container) init(ctx context.Context, retainRetries bool) error {
 	c.state.StoppedByUser = false
 	c.state.RestartPolicyMatch = false
 
+	c.state.StartupHCPassed = false
+	c.state.StartupHCFailureCount = 0
+
 	if !retainRetries {
 		c.state.RestartCount = 0
 	}
 
-	if err := c.save(); err != nil {
-		return err
-	}
 	if c.config.HealthCheckConfig != nil {
-		if err := c.createTimer(); err != nil {
+		if err := c.createTimer(c.isStartupHealthCheck()); err != nil {
 			logrus.Error(err)
 		}
 	}
+	if err := c.save(); err != nil {
+		return err
+	}
 
 	defer c.newContainerEvent(events.Init)
 	return c.completeNetworkSetup()
@@ -1240,7 +1257,7 @@ func (c *Container) start() error {
 		if err := c.updateHealthStatus(define.HealthCheckStarting); err != nil {
 			logrus.Error(err)
 		}
-		if err := c.startTimer(); err != nil {
+		if err := c.startTimer(c.isStartupHealthCheck()); err != nil {
 			logrus.Error(err)
 		}
 	}
@@ -1364,6 +1381,113 @@ func (c *Container) unpause() error {
 	return c.save()
 }
 
+// Internal, non-locking function to update the resource limits of a container
+func (c *Container) update(resources *spec.LinuxResources) error {
+	if resources == nil {
+		return errors.Wrapf(define.ErrInvalidArg, "must provide resource limits to update container %s", c.ID())
+	}
+	if c.config.NoCgroups {
+		return errors.Wrapf(define.ErrNoCgroups, "cannot update resource limits without using CGroups")
+	}
+
+	newConfig := new(ContainerConfig)
+	if err := JSONDeepCopy(c.config, newConfig); err != nil {
+		return errors.Wrapf(err, "error copying configuration of container %s", c.ID())
+	}
+	if newConfig.Spec.Linux == nil {
+		newConfig.Spec.Linux = new(spec.Linux)
+	}
+	if newConfig.Spec.Linux.Resources == nil {
+		newConfig.Spec.Linux.Resources = new(spec.LinuxResources)
+	}
+	mergeLinuxResources(newConfig.Spec.Linux.Resources, resources)
+
+	// Apply the new limits first, the configuration must not be changed
+	// if the OCI runtime refuses them.
+	if c.ensureState(define.ContainerStateRunning, define.ContainerStatePaused) {
+		if err := c.ociRuntime.UpdateContainerResources(c, resources); err != nil {
+			return err
+		}
+	}
+
+	if err := c.runtime.state.SafeRewriteContainerConfig(c, c.Name(), c.Name(), newConfig); err != nil {
+		return errors.Wrapf(err, "error saving resource limits of container %s", c.ID())
+	}
+	c.config = newConfig
+
+	logrus.Debugf("Updated resource limits of container %s", c.ID())
+
+	return nil
+}
+
+// mergeLinuxResources sets all CPU, memory, pids and block IO limits set in
+// src in dst.
+func mergeLinuxResources(dst, src *spec.LinuxResources) {
+	if src.CPU != nil {
+		if dst.CPU == nil {
+			dst.CPU = new(spec.LinuxCPU)
+		}
+		if src.CPU.Shares != nil {
+			dst.CPU.Shares = src.CPU.Shares
+		}
+		if src.CPU.Quota != nil {
+			dst.CPU.Quota = src.CPU.Quota
+		}
+		if src.CPU.Period != nil {
+			dst.CPU.Period = src.CPU.Period
+		}
+		if src.CPU.RealtimeRuntime != nil {
+			dst.CPU.RealtimeRuntime = src.CPU.RealtimeRuntime
+		}
+		if src.CPU.RealtimePeriod != nil {
+			dst.CPU.RealtimePeriod = src.CPU.RealtimePeriod
+		}
+		if src.CPU.Cpus != "" {
+			dst.CPU.Cpus = src.CPU.Cpus
+		}
+		if src.CPU.Mems != "" {
+			dst.CPU.Mems = src.CPU.Mems
+		}
+	}
+	if src.Memory != nil {
+		if dst.Memory == nil {
+			dst.Memory = new(spec.LinuxMemory)
+		}
+		if src.Memory.Limit != nil {
+			dst.Memory.Limit = src.Memory.Limit
+		}
+		if src.Memory.Reservation != nil {
+			dst.Memory.Reservation = src.Memory.Reservation
+		}
+		if src.Memory.Swap != nil {
+			dst.Memory.Swap = src.Memory.Swap
+		}
+		if src.Memory.Kernel != nil {
+			dst.Memory.Kernel = src.Memory.Kernel
+		}
+		if src.Memory.Swappiness != nil {
+			dst.Memory.Swappiness = src.Memory.Swappiness
+		}
+		if src.Memory.DisableOOMKiller != nil {
+			dst.Memory.DisableOOMKiller = src.Memory.DisableOOMKiller
+		}
+	}
+	if src.Pids != nil {
+		dst.Pids = src.Pids
+	}
+	if src.BlockIO != nil {
+		if dst.BlockIO == nil {
+			dst.BlockIO = new(spec.LinuxBlockIO)
+		}
+		if src.BlockIO.Weight != nil {
+			dst.BlockIO.Weight = src.BlockIO.Weight
+		}
+		if src.BlockIO.LeafWeight != nil {
+			dst.BlockIO.LeafWeight = src.BlockIO.LeafWeight
+		}
+	}
+}
+
 // Internal, non-locking function to restart a container
 func (c *Container) restartWithTimeout(ctx context.Context, timeout uint) (retErr error) {
 	if !c.ensureState(define.ContainerStateConfigured, define.ContainerStateCreated, define.ContainerStateRunning, define.ContainerStateStopped, define.ContainerStateExited) {
@@ -1536,7 +1660,7 @@ func (c *Container) mountNamedVolume(v *ContainerNamedVolume, mountpoint string)
 		}
 
 		// If the volume is not empty, we should not copy up.
-		volMount := vol.MountPoint()
+		volMount := vol.mountPoint()
 		contents, err := ioutil.ReadDir(volMount)
 		if err != nil {
 			return nil, errors.Wrapf(err, "error listing contents of volume %s mountpoint when copying up from container %s", vol.Name(), c.ID())
@@ -1601,7 +1725,7 @@ func (c *Container) chownVolume(volumeName string) error {
 			return err
 		}
 
-		mountPoint := vol.MountPoint()
+		mountPoint := vol.mountPoint()
 
 		if err := os.Lchown(mountPoint, uid, gid); err != nil {
 			return err
@@ -21,12 +21,17 @@ import (
 
 	cnitypes "github.com/containernetworking/cni/pkg/types/current"
 	"github.com/containernetworking/plugins/pkg/ns"
+	"github.com/containers/buildah"
 	"github.com/containers/buildah/pkg/overlay"
 	"github.com/containers/buildah/pkg/secrets"
+	buildahUtil "github.com/containers/buildah/util"
 	"github.com/containers/common/pkg/apparmor"
 	"github.com/containers/common/pkg/config"
+	is "github.com/containers/image/v5/storage"
+	"github.com/containers/image/v5/types"
 	"github.com/containers/podman/v2/libpod/define"
 	"github.com/containers/podman/v2/libpod/events"
+	"github.com/containers/podman/v2/libpod/image"
 	"github.com/containers/podman/v2/pkg/annotations"
 	"github.com/containers/podman/v2/pkg/cgroups"
 	"github.com/containers/podman/v2/pkg/criu"
@@ -35,6 +40,7 @@ import (
 	"github.com/containers/podman/v2/pkg/rootless"
 	"github.com/containers/podman/v2/pkg/util"
 	"github.com/containers/podman/v2/utils"
+	"github.com/containers/podman/v2/version"
 	"github.com/containers/storage/pkg/archive"
 	"github.com/containers/storage/pkg/idtools"
 	securejoin "github.com/cyphar/filepath-securejoin"
@@ -328,7 +334,10 @@ func (c *Container) generateSpec(ctx context.Context) (*spec.Spec, error) {
 		if err != nil {
 			return nil, errors.Wrapf(err, "error retrieving volume %s to add to container %s", namedVol.Name, c.ID())
 		}
-		mountPoint := volume.MountPoint()
+		mountPoint, err := volume.MountPoint()
+		if err != nil {
+			return nil, err
+		}
 		volMount := spec.Mount{
 			Type:        "bind",
 			Source:      mountPoint,
@@ -574,6 +583,22 @@ func (c *Container) generateSpec(ctx context.Context) (*spec.Spec, error) {
 		g.AddProcessEnv("container", "libpod")
 	}
 
+	// Add the secrets exposed as environment variables. They are only
+	// added to the spec handed to the OCI runtime.
+	if len(c.config.EnvSecrets) > 0 {
+		manager, err := c.runtime.SecretsManager()
+		if err != nil {
+			return nil, err
+		}
+		for name, secret := range c.config.EnvSecrets {
+			_, data, err := manager.LookupSecretData(secret.ID)
+			if err != nil {
+				return nil, err
+			}
+			g.AddProcessEnv(name, string(data))
+		}
+	}
+
 	cgroupPath, err := c.getOCICgroupPath()
 	if err != nil {
 		return nil, err
@@ -758,8 +783,39 @@ func (c *Container) addNamespaceContainer(g *generate.Generator, ns LinuxNS, ctr
 	return nil
 }
 
-func (c *Container) exportCheckpoint(dest string, ignoreRootfs bool) error {
-	if (len(c.config.NamedVolumes) > 0) || (len(c.Dependencies()) > 0) {
+// updateNamespaceContainers points the namespaces the container shares with
+// other containers to their current paths. The containers it depends on, e.g.
+// the infra container of its pod, may have been restored since the container
+// was checkpointed.
+func (c *Container) updateNamespaceContainers(g *generate.Generator) error {
+	nsCtrs := []struct {
+		ns     LinuxNS
+		ctr    string
+		specNS spec.LinuxNamespaceType
+	}{
+		{IPCNS, c.config.IPCNsCtr, spec.IPCNamespace},
+		{MountNS, c.config.MountNsCtr, spec.MountNamespace},
+		{NetNS, c.config.NetNsCtr, spec.NetworkNamespace},
+		{PIDNS, c.config.PIDNsCtr, spec.PIDNamespace},
+		{UserNS, c.config.UserNsCtr, spec.UserNamespace},
+		{UTSNS, c.config.UTSNsCtr, spec.UTSNamespace},
+		{CgroupNS, c.config.CgroupNsCtr, spec.CgroupNamespace},
+	}
+	for _, nsCtr := range nsCtrs {
+		if nsCtr.ctr == "" {
+			continue
+		}
+		if err := c.addNamespaceContainer(g, nsCtr.ns, nsCtr.ctr, nsCtr.specNS); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Container) exportCheckpoint(dest string, ignoreRootfs, inPod bool) error {
+	// The dependencies of a container can only be exported together with
+	// it if the whole pod is checkpointed.
+	if (len(c.config.NamedVolumes) > 0) || (len(c.Dependencies()) > 0 && !inPod) {
 		return errors.Errorf("Cannot export checkpoints of containers with named volumes or dependencies")
 	}
 	logrus.Debugf("Exporting checkpoint image of container %q to %q", c.ID(), dest)
@@ -770,7 +826,10 @@ func (c *Container) exportCheckpoint(dest string, ignoreRootfs bool) error {
 		"ctr.log",
 		"config.dump",
 		"spec.dump",
-		"network.status"}
+		"network.status",
+		// The memory pages dumped by the pre-checkpoints are
+		// needed to restore a checkpoint based on them
+		"pre-checkpoint"}
 
 	// Get root file-system changes included in the checkpoint archive
 	rootfsDiffPath := filepath.Join(c.bundlePath(), "rootfs-diff.tar")
@@ -869,6 +928,76 @@ func (c *Container) exportCheckpoint(dest string, ignoreRootfs bool) error {
 	return nil
 }
 
+// createCheckpointImage commits the checkpoint of the container to the image
+// options.CreateImage. The single layer of the image holds the content of the
+// exported checkpoint archive, its annotations describe the container.
+func (c *Container) createCheckpointImage(ctx context.Context, options ContainerCheckpointOptions) error {
+	logrus.Debugf("Creating checkpoint image %q of container %q", options.CreateImage, c.ID())
+
+	dir, err := ioutil.TempDir("", "checkpoint")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			logrus.Errorf("could not recursively remove %s: %q", dir, err)
+		}
+	}()
+	exportFile := filepath.Join(dir, "checkpoint.tar.gz")
+	if err := c.exportCheckpoint(exportFile, options.IgnoreRootfs, options.pod); err != nil {
+		return err
+	}
+
+	builderOptions := buildah.BuilderOptions{
+		FromImage:       "scratch",
+		CommonBuildOpts: &buildah.CommonBuildOptions{},
+		Format:          buildah.OCIv1ImageManifest,
+	}
+	importBuilder, err := buildah.NewBuilder(ctx, c.runtime.store, builderOptions)
+	if err != nil {
+		return errors.Wrapf(err, "error creating checkpoint image of container %s", c.ID())
+	}
+	defer func() {
+		if err := importBuilder.Delete(); err != nil {
+			logrus.Errorf("Error removing working container of checkpoint image: %v", err)
+		}
+	}()
+
+	// The archive is extracted into the layer of the image
+	if err := importBuilder.Add("/", true, buildah.AddAndCopyOptions{}, exportFile); err != nil {
+		return errors.Wrapf(err, "error adding checkpoint of container %s to image", c.ID())
+	}
+
+	importBuilder.SetAnnotation(define.CheckpointAnnotationName, c.Name())
+	importBuilder.SetAnnotation(define.CheckpointAnnotationRawImageName, c.config.RawImageName)
+	importBuilder.SetAnnotation(define.CheckpointAnnotationRootfsImageID, c.config.RootfsImageID)
+	importBuilder.SetAnnotation(define.CheckpointAnnotationRootfsImageName, c.config.RootfsImageName)
+	importBuilder.SetAnnotation(define.CheckpointAnnotationPodmanVersion, version.Version.String())
+	importBuilder.SetAnnotation(define.CheckpointAnnotationRuntimeName, c.ociRuntime.Name())
+
+	sc := image.GetSystemContext("", "", false)
+	var imageRef types.ImageReference
+	candidates, _, _, err := buildahUtil.ResolveName(options.CreateImage, "", sc, c.runtime.store)
+	if err != nil {
+		return errors.Wrapf(err, "error resolving name %q", options.CreateImage)
+	}
+	if len(candidates) == 0 {
+		return errors.Errorf("error resolving name %q: no valid image name", options.CreateImage)
+	}
+	imageRef, err = is.Transport.ParseStoreReference(c.runtime.store, candidates[0])
+	if err != nil {
+		return errors.Wrapf(err, "error parsing target image name %q", options.CreateImage)
+	}
+	commitOptions := buildah.CommitOptions{
+		SystemContext:         sc,
+		PreferredManifestType: buildah.OCIv1ImageManifest,
+	}
+	if _, _, _, err := importBuilder.Commit(ctx, imageRef, commitOptions); err != nil {
+		return errors.Wrapf(err, "error committing checkpoint image %q", options.CreateImage)
+	}
+	return nil
+}
+
 func (c *Container) checkpointRestoreSupported() error {
 	if !criu.CheckForCriu() {
 		return errors.Errorf("Checkpoint/Restore requires at least CRIU %d", criu.MinCriuVersion)
@@ -879,6 +1008,19 @@ func (c *Container) checkpointRestoreSupported() error {
 	return nil
 }
 
+// preCheckPointCount returns the number of pre-checkpoints of the container.
+// The pre-checkpoints are written to numbered directories, starting at 1.
+func (c *Container) preCheckPointCount() (int, error) {
+	entries, err := ioutil.ReadDir(c.PreCheckPointPath())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, nil
+		}
+		return 0, errors.Wrapf(err, "error reading pre-checkpoints of container %s", c.ID())
+	}
+	return len(entries), nil
+}
+
 func (c *Container) checkpointRestoreLabelLog(fileName string) error {
 	// Create the CRIU log file and label it
 	dumpLog := filepath.Join(c.bundlePath(), fileName)
@@ -901,12 +1043,35 @@ func (c *Container) checkpoint(ctx context.Context, options ContainerCheckpointO
 		return err
 	}
 
-	if c.state.State != define.ContainerStateRunning {
+	// The containers of a pod are paused first to checkpoint all of them
+	// at the same point in time.
+	if c.state.State != define.ContainerStateRunning && !(options.pod && c.state.State == define.ContainerStatePaused) {
 		return errors.Wrapf(define.ErrCtrStateInvalid, "%q is not running, cannot checkpoint", c.state.State)
 	}
 
-	if c.AutoRemove() && options.TargetFile == "" {
-		return errors.Errorf("Cannot checkpoint containers that have been started with '--rm' unless '--export' is used")
+	if options.PreCheckPoint && (options.TargetFile != "" || options.CreateImage != "") {
+		return errors.Wrapf(define.ErrInvalidArg, "cannot export a pre-checkpoint, export the checkpoint based on it instead")
+	}
+
+	if c.AutoRemove() && options.TargetFile == "" && options.CreateImage == "" && !options.PreCheckPoint {
+		return errors.Errorf("Cannot checkpoint containers that have been started with '--rm' unless '--export' or '--create-image' is used")
+	}
+
+	if options.WithPrevious {
+		preCheckPoints, err := c.preCheckPointCount()
+		if err != nil {
+			return err
+		}
+		if preCheckPoints == 0 {
+			return errors.Wrapf(define.ErrInvalidArg, "container %s has no pre-checkpoint to base the checkpoint on", c.ID())
+		}
+	} else {
+		// A pre-checkpoint without a previous one starts a new chain
+		// of pre-checkpoints, and a checkpoint without a previous one
+		// does not need the old chain.
+		if err := os.RemoveAll(c.PreCheckPointPath()); err != nil {
+			return errors.Wrapf(err, "error removing pre-checkpoints of container %s", c.ID())
+		}
 	}
 
 	if err := c.checkpointRestoreLabelLog("dump.log"); err != nil {
@@ -917,6 +1082,21 @@ func (c *Container) checkpoint(ctx context.Context, options ContainerCheckpointO
 		return err
 	}
 
+	if options.PreCheckPoint {
+		// The container keeps running, only its memory pages have been
+		// written to disk.
+		logrus.Debugf("Pre-checkpointed container %s", c.ID())
+		if !options.Keep {
+			for _, del := range []string{"dump.log", "stats-dump"} {
+				file := filepath.Join(c.bundlePath(), del)
+				if err := os.Remove(file); err != nil {
+					logrus.Debugf("unable to remove file %s", file)
+				}
+			}
+		}
+		return nil
+	}
+
 	// Save network.status. This is needed to restore the container with
 	// the same IP. Currently limited to one IP address in a container
 	// with one interface.
@@ -931,7 +1111,13 @@ func (c *Container) checkpoint(ctx context.Context, options ContainerCheckpointO
 	defer c.newContainerEvent(events.Checkpoint)
 
 	if options.TargetFile != "" {
-		if err = c.exportCheckpoint(options.TargetFile, options.IgnoreRootfs); err != nil {
+		if err = c.exportCheckpoint(options.TargetFile, options.IgnoreRootfs, options.pod); err != nil {
+			return err
+		}
+	}
+
+	if options.CreateImage != "" {
+		if err = c.createCheckpointImage(ctx, options); err != nil {
 			return err
 		}
 	}
@@ -1118,6 +1304,10 @@ func (c *Container) restore(ctx context.Context, options ContainerCheckpointOpti
 		g.SetRootPath(c.state.Mountpoint)
 	}
 
+	if err := c.updateNamespaceContainers(&g); err != nil {
+		return err
+	}
+
 	// We want to have the same network namespace as before.
 	if c.config.CreateNetNS {
 		netNSPath := ""
@@ -1213,6 +1403,10 @@ func (c *Container) restore(ctx context.Context, options ContainerCheckpointOpti
 		if err != nil {
 			logrus.Debugf("Non-fatal: removal of checkpoint directory (%s) failed: %v", c.CheckpointPath(), err)
 		}
+		err = os.RemoveAll(c.PreCheckPointPath())
+		if err != nil {
+			logrus.Debugf("Non-fatal: removal of pre-checkpoint directory (%s) failed: %v", c.PreCheckPointPath(), err)
+		}
 		cleanup := [...]string{"restore.log", "dump.log", "stats-dump", "stats-restore", "network.status", "rootfs-diff.tar", "deleted.files"}
 		for _, del := range cleanup {
 			file := filepath.Join(c.bundlePath(), del)
@@ -1442,6 +1636,56 @@ func (c *Container) makeBindMounts() error {
 		}
 	}
 
+	// Add the secrets added with --secret
+	if len(c.config.Secrets) > 0 {
+		if err := c.makeSecretMounts(); err != nil {
+			return errors.Wrapf(err, "error creating secret mounts for container %s", c.ID())
+		}
+	}
+
+	return nil
+}
+
+// makeSecretMounts writes the data of the container's secrets into its run
+// directory, which lives on tmpfs, and bind mounts every secret to
+// /run/secrets/<name>.
+func (c *Container) makeSecretMounts() error {
+	manager, err := c.runtime.SecretsManager()
+	if err != nil {
+		return err
+	}
+
+	secretsDir := filepath.Join(c.state.RunDir, "secrets")
+	if err := os.MkdirAll(secretsDir, 0700); err != nil {
+		return err
+	}
+	if err := os.Chown(secretsDir, c.RootUID(), c.RootGID()); err != nil {
+		return err
+	}
+
+	for _, secret := range c.config.Secrets {
+		_, data, err := manager.LookupSecretData(secret.ID)
+		if err != nil {
+			return err
+		}
+		secretFile := filepath.Join(secretsDir, secret.Name)
+		// The file is read-only, remove it first if the container is
+		// being restarted.
+		if err := os.Remove(secretFile); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		if err := ioutil.WriteFile(secretFile, data, 0444); err != nil {
+			return errors.Wrapf(err, "unable to write secret %s", secret.Name)
+		}
+		if err := os.Chown(secretFile, c.RootUID(), c.RootGID()); err != nil {
+			return err
+		}
+		if err := label.Relabel(secretFile, c.config.MountLabel, false); err != nil {
+			return err
+		}
+		c.state.BindMounts[filepath.Join("/run/secrets", secret.Name)] = secretFile
+	}
+
 	return nil
 }
 
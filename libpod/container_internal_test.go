@@ -82,3 +82,37 @@ func init() {
 		panic("we need a reliable executable path on Windows")
 	}
 }
+
+func TestMergeLinuxResources(t *testing.T) {
+	shares := uint64(1024)
+	quota := int64(50000)
+	limit := int64(1 << 20)
+	dst := &rspec.LinuxResources{
+		CPU: &rspec.LinuxCPU{
+			Shares: &shares,
+			Quota:  &quota,
+			Cpus:   "0-1",
+		},
+	}
+
+	newShares := uint64(512)
+	mergeLinuxResources(dst, &rspec.LinuxResources{
+		CPU: &rspec.LinuxCPU{
+			Shares: &newShares,
+		},
+		Memory: &rspec.LinuxMemory{
+			Limit: &limit,
+		},
+		Pids: &rspec.LinuxPids{
+			Limit: 100,
+		},
+	})
+
+	assert.Equal(t, newShares, *dst.CPU.Shares)
+	assert.Equal(t, quota, *dst.CPU.Quota)
+	assert.Equal(t, "0-1", dst.CPU.Cpus)
+	assert.Equal(t, limit, *dst.Memory.Limit)
+	assert.Nil(t, dst.Memory.Swap)
+	assert.Equal(t, int64(100), dst.Pids.Limit)
+	assert.Nil(t, dst.BlockIO)
+}
@@ -42,6 +42,60 @@ func (c *Container) ReadLog(ctx context.Context, options *logs.LogOptions, logCh
 	}
 }
 
+// logRotationBackstop is the multiple of the maximum size at which conmon
+// truncates a log file that libpod rotates. libpod rotates log files only when
+// it syncs the container, so conmon still caps the logs of containers that no
+// podman command touches.
+const logRotationBackstop = 2
+
+// logRotation returns true if the log file of the container is rotated by
+// libpod once it reaches its maximum size.
+func (c *Container) logRotation() bool {
+	if c.config.LogMaxFiles < 2 || c.config.LogSize <= 0 {
+		return false
+	}
+	switch c.LogDriver() {
+	case define.KubernetesLogging, define.JSONLogging, "":
+		return true
+	}
+	return false
+}
+
+// rotateLog rotates the log file of the container if it has reached its
+// maximum size. libpod rotates the log file whenever it syncs the state of the
+// container and asks conmon to reopen the log file of a running container.
+// For any other container, an empty log file is created, so the logs can
+// still be read. conmon truncates the log file if it reaches
+// logRotationBackstop times the maximum size before.
+func (c *Container) rotateLog() error {
+	if !c.logRotation() {
+		return nil
+	}
+	info, err := os.Stat(c.LogPath())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return errors.Wrapf(err, "unable to stat log file of container %s", c.ID())
+	}
+	if info.Size() < c.config.LogSize {
+		return nil
+	}
+
+	logrus.Debugf("Rotating log file %s of container %s", c.LogPath(), c.ID())
+	if err := logs.RotateLogFile(c.LogPath(), c.config.LogMaxFiles, c.config.LogCompress); err != nil {
+		return err
+	}
+	if c.ensureState(define.ContainerStateRunning, define.ContainerStatePaused) {
+		return c.ociRuntime.ReopenContainerLog(c)
+	}
+	f, err := os.OpenFile(c.LogPath(), os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return errors.Wrapf(err, "unable to create log file of container %s", c.ID())
+	}
+	return f.Close()
+}
+
 func (c *Container) readFromLogFile(ctx context.Context, options *logs.LogOptions, logChannel chan *logs.LogLine) error {
 	t, tailLog, err := logs.GetLogFile(c.LogPath(), options)
 	if err != nil {
@@ -56,7 +110,7 @@ func (c *Container) readFromLogFile(ctx context.Context, options *logs.LogOption
 		for _, nll := range tailLog {
 			nll.CID = c.ID()
 			nll.CName = c.Name()
-			if nll.Since(options.Since) {
+			if nll.Matches(options) {
 				logChannel <- nll
 			}
 		}
@@ -88,7 +142,7 @@ func (c *Container) readFromLogFile(ctx context.Context, options *logs.LogOption
 			}
 			nll.CID = c.ID()
 			nll.CName = c.Name()
-			if nll.Since(options.Since) {
+			if nll.Matches(options) {
 				logChannel <- nll
 			}
 		}
@@ -109,7 +163,10 @@ func (c *Container) readFromLogFile(ctx context.Context, options *logs.LogOption
 					}
 					break
 				}
-				if state != define.ContainerStateRunning && state != define.ContainerStatePaused {
+				// Stop following once the container exited or the
+				// until time has passed.
+				untilPassed := !options.Until.IsZero() && time.Now().After(options.Until)
+				if untilPassed || (state != define.ContainerStateRunning && state != define.ContainerStatePaused) {
 					tailError := t.StopAtEOF()
 					if tailError != nil && fmt.Sprintf("%v", tailError) != "tail: stop at eof" {
 						logrus.Error(tailError)
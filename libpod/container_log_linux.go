@@ -69,14 +69,21 @@ func (c *Container) readFromJournal(ctx context.Context, options *logs.LogOption
 			done := make(chan bool)
 			until := make(chan time.Time)
 			go func() {
+				var untilPassed <-chan time.Time
+				if !options.Until.IsZero() {
+					untilPassed = time.After(time.Until(options.Until))
+				}
 				select {
 				case <-ctx.Done():
 					until <- time.Time{}
+				case <-untilPassed:
+					// stop following once the until time has passed
+					until <- time.Time{}
 				case <-done:
 					// nothing to do anymore
 				}
 			}()
-			follower := FollowBuffer{logChannel}
+			follower := FollowBuffer{logChannel: logChannel, options: options}
 			err := r.Follow(until, follower)
 			if err != nil {
 				logrus.Debugf(err.Error())
@@ -102,7 +109,14 @@ func (c *Container) readFromJournal(ctx context.Context, options *logs.LogOption
 				logrus.Error(err2)
 				continue
 			}
-			logChannel <- logLine
+			if !logLine.Until(options.Until) {
+				// journal entries are ordered, all following
+				// entries are past the until time as well
+				break
+			}
+			if logLine.FromStream(options.Stream) {
+				logChannel <- logLine
+			}
 			ec, err = r.Read(bytes)
 		}
 		if err != nil && err != io.EOF {
@@ -148,6 +162,7 @@ func journalFormatter(entry *journal.JournalEntry) (string, error) {
 
 type FollowBuffer struct {
 	logChannel chan *logs.LogLine
+	options    *logs.LogOptions
 }
 
 func (f FollowBuffer) Write(p []byte) (int, error) {
@@ -156,6 +171,8 @@ func (f FollowBuffer) Write(p []byte) (int, error) {
 	if err != nil {
 		return -1, err
 	}
-	f.logChannel <- logLine
+	if f.options == nil || logLine.Matches(f.options) {
+		f.logChannel <- logLine
+	}
 	return len(p), nil
 }
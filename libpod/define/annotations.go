@@ -65,4 +65,45 @@ const (
 	// InspectResponseFalse is a boolean False response for an inspect
 	// annotation.
 	InspectResponseFalse = "FALSE"
+
+	// VolumeDriverAnnotation is set on the PersistentVolumeClaims that
+	// generate kube creates for named volumes. It holds the driver of the
+	// volume, so play kube can recreate it with the same driver.
+	VolumeDriverAnnotation = "volume.podman.io/driver"
+	// VolumeOptionAnnotationPrefix is the prefix of the annotations that
+	// hold the options of a named volume on the PersistentVolumeClaims that
+	// generate kube creates. The name of the option follows the prefix.
+	VolumeOptionAnnotationPrefix = "volume.podman.io/option."
+
+	// KubeHealthCheckOnFailureAnnotation is the prefix of the pod
+	// annotations that generate kube sets to record the healthcheck
+	// on-failure action of a container. The name of the container follows
+	// the prefix, separated by a slash.
+	KubeHealthCheckOnFailureAnnotation = "io.podman.annotations.health-on-failure"
+	// KubePodResourceLimitsAnnotation is the prefix of the pod annotations
+	// that generate kube sets to record the resource limits of the pod
+	// cgroup, as Kubernetes pods have no pod-level limits. The name of
+	// the resource (cpu, cpuset, memory or pids) follows the prefix,
+	// separated by a slash.
+	KubePodResourceLimitsAnnotation = "io.podman.annotations.pod-limits"
+
+	// CheckpointAnnotationName is set on the images created by
+	// 'podman container checkpoint --create-image'. It holds the name of
+	// the checkpointed container and marks the image as checkpoint image.
+	CheckpointAnnotationName = "io.podman.annotations.checkpoint.name"
+	// CheckpointAnnotationRawImageName holds the image name the
+	// checkpointed container has been created with.
+	CheckpointAnnotationRawImageName = "io.podman.annotations.checkpoint.rawImageName"
+	// CheckpointAnnotationRootfsImageID holds the ID of the image of the
+	// root file-system of the checkpointed container.
+	CheckpointAnnotationRootfsImageID = "io.podman.annotations.checkpoint.rootfsImageID"
+	// CheckpointAnnotationRootfsImageName holds the name of the image of
+	// the root file-system of the checkpointed container.
+	CheckpointAnnotationRootfsImageName = "io.podman.annotations.checkpoint.rootfsImageName"
+	// CheckpointAnnotationPodmanVersion holds the version of Podman that
+	// created the checkpoint image.
+	CheckpointAnnotationPodmanVersion = "io.podman.annotations.checkpoint.podman.version"
+	// CheckpointAnnotationRuntimeName holds the name of the OCI runtime
+	// that checkpointed the container.
+	CheckpointAnnotationRuntimeName = "io.podman.annotations.checkpoint.runtime.name"
 )
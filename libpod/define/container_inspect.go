@@ -51,6 +51,17 @@ type InspectContainerConfig struct {
 	StopSignal uint `json:"StopSignal"`
 	// Configured healthcheck for the container
 	Healthcheck *manifest.Schema2HealthConfig `json:"Healthcheck,omitempty"`
+	// HealthcheckOnFailureAction defines an action to take once the container turns unhealthy.
+	HealthcheckOnFailureAction string `json:"HealthcheckOnFailureAction,omitempty"`
+	// StartupHealthCheck is the startup healthcheck of the container, which
+	// runs until it succeeds once before the regular healthcheck takes over.
+	StartupHealthCheck *manifest.Schema2HealthConfig `json:"StartupHealthCheck,omitempty"`
+	// HealthMaxLogCount is the number of healthcheck results kept in the
+	// healthcheck log.
+	HealthMaxLogCount uint `json:"HealthMaxLogCount,omitempty"`
+	// HealthMaxLogSize is the maximum length of the output of a healthcheck
+	// kept in the healthcheck log.
+	HealthMaxLogSize uint `json:"HealthMaxLogSize,omitempty"`
 	// CreateCommand is the full command plus arguments of the process the
 	// container has been created with.
 	CreateCommand []string `json:"CreateCommand,omitempty"`
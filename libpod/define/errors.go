@@ -23,6 +23,10 @@ var (
 	// ErrNoSuchVolume indicates the requested volume does not exist
 	ErrNoSuchVolume = errors.New("no such volume")
 
+	// ErrMissingPlugin indicates that the requested operation requires a
+	// plugin that is not present on the system or in the configuration.
+	ErrMissingPlugin = errors.New("required plugin missing")
+
 	// ErrNoSuchNetwork indicates the requested network does not exist
 	ErrNoSuchNetwork = errors.New("network not found")
 
@@ -58,6 +62,8 @@ var (
 	ErrExecSessionStateInvalid = errors.New("exec session state improper")
 	// ErrVolumeBeingUsed indicates that a volume is being used by at least one container
 	ErrVolumeBeingUsed = errors.New("volume is being used")
+	// ErrSecretBeingUsed indicates that a secret is being used by at least one container
+	ErrSecretBeingUsed = errors.New("secret is being used")
 
 	// ErrRuntimeFinalized indicates that the runtime has already been
 	// created and cannot be modified
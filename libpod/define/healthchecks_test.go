@@ -0,0 +1,34 @@
+package define
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseHealthCheckOnFailureAction(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected HealthCheckOnFailureAction
+	}{
+		{"", HealthCheckOnFailureActionNone},
+		{"none", HealthCheckOnFailureActionNone},
+		{"kill", HealthCheckOnFailureActionKill},
+		{"restart", HealthCheckOnFailureActionRestart},
+		{"stop", HealthCheckOnFailureActionStop},
+	}
+	for _, test := range tests {
+		action, err := ParseHealthCheckOnFailureAction(test.input)
+		require.NoError(t, err, test.input)
+		assert.Equal(t, test.expected, action, test.input)
+		if test.input != "" {
+			assert.Equal(t, test.input, action.String())
+		}
+	}
+
+	action, err := ParseHealthCheckOnFailureAction("foo")
+	assert.Error(t, err)
+	assert.Equal(t, HealthCheckOnFailureActionInvalid, action)
+	assert.Equal(t, "invalid", action.String())
+}
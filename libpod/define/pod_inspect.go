@@ -33,6 +33,16 @@ type InspectPodData struct {
 	CgroupParent string `json:"CgroupParent,omitempty"`
 	// CgroupPath is the path to the pod's CGroup.
 	CgroupPath string `json:"CgroupPath,omitempty"`
+	// CPUPeriod is the CFS period of the pod's CGroup in microseconds.
+	CPUPeriod uint64 `json:"CPUPeriod,omitempty"`
+	// CPUQuota is the CFS quota of the pod's CGroup in microseconds.
+	CPUQuota int64 `json:"CPUQuota,omitempty"`
+	// CPUSetCPUs is the set of CPUs the containers of the pod may run on.
+	CPUSetCPUs string `json:"CPUSetCPUs,omitempty"`
+	// MemoryLimit is the memory limit of the pod's CGroup in bytes.
+	MemoryLimit int64 `json:"MemoryLimit,omitempty"`
+	// PidsLimit is the maximum number of processes in the pod's CGroup.
+	PidsLimit int64 `json:"PidsLimit,omitempty"`
 	// CreateInfra is whether this pod will create an infra container to
 	// share namespaces.
 	CreateInfra bool
@@ -3,6 +3,7 @@ package libpod
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"sync"
 
 	"github.com/containers/podman/v2/libpod/events"
@@ -37,6 +38,56 @@ func (c *Container) newContainerEvent(status events.Status) {
 	}
 }
 
+// NewAutoUpdateEvent creates a new event for an auto update of the
+// container. If rollback is set, the update failed and the container was
+// rolled back to its previous image.
+func (c *Container) NewAutoUpdateEvent(rollback bool) {
+	e := events.NewEvent(events.AutoUpdate)
+	e.ID = c.ID()
+	e.Name = c.Name()
+	e.Image = c.config.RootfsImageName
+	e.Type = events.Container
+
+	attributes := make(map[string]string)
+	for k, v := range c.Labels() {
+		attributes[k] = v
+	}
+	attributes["rollback"] = strconv.FormatBool(rollback)
+	e.Details = events.Details{
+		ID:         e.ID,
+		Attributes: attributes,
+	}
+
+	if err := c.runtime.eventer.Write(e); err != nil {
+		logrus.Errorf("unable to write auto-update event: %q", err)
+	}
+}
+
+// newHealthStatusEvent creates a new event for a container turning
+// unhealthy, recording the health status and the on-failure action taken.
+func (c *Container) newHealthStatusEvent(status string) {
+	e := events.NewEvent(events.HealthStatus)
+	e.ID = c.ID()
+	e.Name = c.Name()
+	e.Image = c.config.RootfsImageName
+	e.Type = events.Container
+
+	attributes := make(map[string]string)
+	for k, v := range c.Labels() {
+		attributes[k] = v
+	}
+	attributes["health_status"] = status
+	attributes["health_on_failure"] = c.HealthCheckOnFailureAction().String()
+	e.Details = events.Details{
+		ID:         e.ID,
+		Attributes: attributes,
+	}
+
+	if err := c.runtime.eventer.Write(e); err != nil {
+		logrus.Errorf("unable to write health status event: %q", err)
+	}
+}
+
 // newContainerExitedEvent creates a new event for a container's death
 func (c *Container) newContainerExitedEvent(exitCode int32) {
 	e := events.NewEvent(events.Exited)
@@ -133,6 +133,8 @@ const (
 	Exited Status = "died"
 	// Export ...
 	Export Status = "export"
+	// HealthStatus ...
+	HealthStatus Status = "health_status"
 	// History ...
 	History Status = "history"
 	// Import ...
@@ -162,6 +164,8 @@ const (
 	Refresh Status = "refresh"
 	// Remove ...
 	Remove Status = "remove"
+	// Rename indicates that a container was renamed.
+	Rename Status = "rename"
 	// Renumber indicates that lock numbers were reallocated at user
 	// request.
 	Renumber Status = "renumber"
@@ -185,6 +189,9 @@ const (
 	Unpause Status = "unpause"
 	// Untag ...
 	Untag Status = "untag"
+	// Update indicates that the resource limits of a container were
+	// updated.
+	Update Status = "update"
 )
 
 // EventFilter for filtering events
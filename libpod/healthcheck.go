@@ -3,10 +3,12 @@ package libpod
 import (
 	"bufio"
 	"bytes"
+	"context"
 	"io/ioutil"
 	"os"
 	"path/filepath"
 	"strings"
+	"syscall"
 	"time"
 
 	"github.com/containers/podman/v2/libpod/define"
@@ -15,10 +17,11 @@ import (
 )
 
 const (
-	// MaxHealthCheckNumberLogs is the maximum number of attempts we keep
-	// in the healthcheck history file
+	// MaxHealthCheckNumberLogs is the default maximum number of attempts
+	// we keep in the healthcheck history file
 	MaxHealthCheckNumberLogs int = 5
-	// MaxHealthCheckLogLength in characters
+	// MaxHealthCheckLogLength is the default maximum length of the output
+	// of an attempt in characters
 	MaxHealthCheckLogLength = 500
 )
 
@@ -46,7 +49,9 @@ func (r *Runtime) HealthCheck(name string) (define.HealthCheckStatus, error) {
 	return hcStatus, err
 }
 
-// runHealthCheck runs the health check as defined by the container
+// runHealthCheck runs the health check as defined by the container.  As long
+// as the startup healthcheck of the container has not succeeded, it is run
+// instead of the regular one.
 func (c *Container) runHealthCheck() (define.HealthCheckStatus, error) {
 	var (
 		newCommand    []string
@@ -54,7 +59,12 @@ func (c *Container) runHealthCheck() (define.HealthCheckStatus, error) {
 		capture       bytes.Buffer
 		inStartPeriod bool
 	)
-	hcCommand := c.HealthCheckConfig().Test
+	isStartup := c.isStartupHealthCheck()
+	hcConfig := c.HealthCheckConfig()
+	if isStartup {
+		hcConfig = c.StartupHealthCheckConfig()
+	}
+	hcCommand := hcConfig.Test
 	if len(hcCommand) < 1 {
 		return define.HealthCheckNotDefined, errors.Errorf("container %s has no defined healthcheck", c.ID())
 	}
@@ -109,9 +119,9 @@ func (c *Container) runHealthCheck() (define.HealthCheckStatus, error) {
 		returnCode = 1
 	}
 	timeEnd := time.Now()
-	if c.HealthCheckConfig().StartPeriod > 0 {
+	if !isStartup && hcConfig.StartPeriod > 0 {
 		// there is a start-period we need to honor; we add startPeriod to container start time
-		startPeriodTime := c.state.StartedTime.Add(c.HealthCheckConfig().StartPeriod)
+		startPeriodTime := c.state.StartedTime.Add(hcConfig.StartPeriod)
 		if timeStart.Before(startPeriodTime) {
 			// we are still in the start period, flip the inStartPeriod bool
 			inStartPeriod = true
@@ -120,22 +130,121 @@ func (c *Container) runHealthCheck() (define.HealthCheckStatus, error) {
 	}
 
 	eventLog := capture.String()
-	if len(eventLog) > MaxHealthCheckLogLength {
-		eventLog = eventLog[:MaxHealthCheckLogLength]
+	if maxLogSize := c.healthCheckMaxLogSize(); len(eventLog) > maxLogSize {
+		eventLog = eventLog[:maxLogSize]
 	}
 
-	if timeEnd.Sub(timeStart) > c.HealthCheckConfig().Timeout {
+	if timeEnd.Sub(timeStart) > hcConfig.Timeout {
 		returnCode = -1
 		hcResult = define.HealthCheckFailure
-		hcErr = errors.Errorf("healthcheck command exceeded timeout of %s", c.HealthCheckConfig().Timeout.String())
+		hcErr = errors.Errorf("healthcheck command exceeded timeout of %s", hcConfig.Timeout.String())
 	}
 	hcl := newHealthCheckLog(timeStart, timeEnd, returnCode, eventLog)
-	if err := c.updateHealthCheckLog(hcl, inStartPeriod); err != nil {
+	if isStartup {
+		if err := c.processStartupHealthCheck(hcl); err != nil {
+			return hcResult, err
+		}
+		return hcResult, hcErr
+	}
+	hcStatus, err := c.updateHealthCheckLog(hcl, inStartPeriod)
+	if err != nil {
 		return hcResult, errors.Wrapf(err, "unable to update health check log %s for %s", c.healthCheckLogPath(), c.ID())
 	}
+	if err := c.processHealthCheckStatus(hcStatus); err != nil {
+		return hcResult, err
+	}
 	return hcResult, hcErr
 }
 
+// isStartupHealthCheck returns true if the container has a startup
+// healthcheck that has not succeeded yet
+func (c *Container) isStartupHealthCheck() bool {
+	return c.config.StartupHealthCheckConfig != nil && !c.state.StartupHCPassed
+}
+
+// processStartupHealthCheck records the result of a startup healthcheck.
+// Once the startup healthcheck succeeds, the regular healthcheck takes over.
+// Like a failing Kubernetes startupProbe, a startup healthcheck that fails
+// more often than its retries restarts the container.
+func (c *Container) processStartupHealthCheck(hcl define.HealthCheckLog) error {
+	healthCheck, err := c.GetHealthCheckLog()
+	if err != nil {
+		return err
+	}
+	c.appendHealthCheckLog(&healthCheck, hcl)
+	if err := c.writeHealthCheckLog(healthCheck); err != nil {
+		return errors.Wrapf(err, "unable to update health check log %s for %s", c.healthCheckLogPath(), c.ID())
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if err := c.syncContainer(); err != nil {
+		return err
+	}
+
+	if hcl.ExitCode == 0 {
+		logrus.Debugf("startup healthcheck for %s succeeded, starting regular healthcheck", c.ID())
+		c.state.StartupHCPassed = true
+		c.state.StartupHCFailureCount = 0
+		if err := c.removeTimer(); err != nil {
+			logrus.Errorf("Error removing timer for container %s startup healthcheck: %v", c.ID(), err)
+		}
+		if err := c.createTimer(false); err != nil {
+			logrus.Error(err)
+		}
+		if err := c.save(); err != nil {
+			return err
+		}
+		if err := c.startTimer(false); err != nil {
+			logrus.Error(err)
+		}
+		return nil
+	}
+
+	c.state.StartupHCFailureCount++
+	retries := c.StartupHealthCheckConfig().Retries
+	if retries < 1 || c.state.StartupHCFailureCount < retries {
+		return c.save()
+	}
+	logrus.Infof("startup healthcheck for %s failed %d times, restarting container", c.ID(), c.state.StartupHCFailureCount)
+	if err := c.restartWithTimeout(context.Background(), c.StopTimeout()); err != nil {
+		return errors.Wrapf(err, "unable to restart container %s after its startup healthcheck failed", c.ID())
+	}
+	return nil
+}
+
+// processHealthCheckStatus runs the on-failure action of the container if
+// the specified health status is unhealthy
+func (c *Container) processHealthCheckStatus(status string) error {
+	if status != define.HealthCheckUnhealthy {
+		return nil
+	}
+
+	action := c.HealthCheckOnFailureAction()
+	if action == define.HealthCheckOnFailureActionNone {
+		return nil
+	}
+	c.newHealthStatusEvent(status)
+
+	switch action {
+	case define.HealthCheckOnFailureActionKill:
+		if err := c.Kill(uint(syscall.SIGKILL)); err != nil {
+			return errors.Wrapf(err, "unable to kill unhealthy container %s", c.ID())
+		}
+	case define.HealthCheckOnFailureActionRestart:
+		if err := c.RestartWithTimeout(context.Background(), c.StopTimeout()); err != nil {
+			return errors.Wrapf(err, "unable to restart unhealthy container %s", c.ID())
+		}
+	case define.HealthCheckOnFailureActionStop:
+		if err := c.Stop(); err != nil {
+			return errors.Wrapf(err, "unable to stop unhealthy container %s", c.ID())
+		}
+	default:
+		return errors.Errorf("unsupported on-failure action %q for health check of container %s", action.String(), c.ID())
+	}
+	return nil
+}
+
 func checkHealthCheckCanBeRun(c *Container) (define.HealthCheckStatus, error) {
 	cstate, err := c.State()
 	if err != nil {
@@ -167,6 +276,11 @@ func (c *Container) updateHealthStatus(status string) error {
 		return err
 	}
 	healthCheck.Status = status
+	if status == define.HealthCheckStarting {
+		// a (re)started container must not inherit the failing streak
+		// of its previous run
+		healthCheck.FailingStreak = 0
+	}
 	newResults, err := json.Marshal(healthCheck)
 	if err != nil {
 		return errors.Wrapf(err, "unable to marshall healthchecks for writing status")
@@ -174,11 +288,12 @@ func (c *Container) updateHealthStatus(status string) error {
 	return ioutil.WriteFile(c.healthCheckLogPath(), newResults, 0700)
 }
 
-// UpdateHealthCheckLog parses the health check results and writes the log
-func (c *Container) updateHealthCheckLog(hcl define.HealthCheckLog, inStartPeriod bool) error {
+// UpdateHealthCheckLog parses the health check results, writes the log and
+// returns the resulting health status
+func (c *Container) updateHealthCheckLog(hcl define.HealthCheckLog, inStartPeriod bool) (string, error) {
 	healthCheck, err := c.GetHealthCheckLog()
 	if err != nil {
-		return err
+		return "", err
 	}
 	if hcl.ExitCode == 0 {
 		//	set status to healthy, reset failing state to 0
@@ -197,10 +312,21 @@ func (c *Container) updateHealthCheckLog(hcl define.HealthCheckLog, inStartPerio
 			}
 		}
 	}
+	c.appendHealthCheckLog(&healthCheck, hcl)
+	return healthCheck.Status, c.writeHealthCheckLog(healthCheck)
+}
+
+// appendHealthCheckLog appends the result to the healthcheck log and drops
+// the oldest results exceeding the configured number of results to keep
+func (c *Container) appendHealthCheckLog(healthCheck *define.HealthCheckResults, hcl define.HealthCheckLog) {
 	healthCheck.Log = append(healthCheck.Log, hcl)
-	if len(healthCheck.Log) > MaxHealthCheckNumberLogs {
-		healthCheck.Log = healthCheck.Log[1:]
+	if maxLogCount := c.healthCheckMaxLogCount(); len(healthCheck.Log) > maxLogCount {
+		healthCheck.Log = healthCheck.Log[len(healthCheck.Log)-maxLogCount:]
 	}
+}
+
+// writeHealthCheckLog writes the healthcheck log of the container
+func (c *Container) writeHealthCheckLog(healthCheck define.HealthCheckResults) error {
 	newResults, err := json.Marshal(healthCheck)
 	if err != nil {
 		return errors.Wrapf(err, "unable to marshall healthchecks for writing")
@@ -208,6 +334,24 @@ func (c *Container) updateHealthCheckLog(hcl define.HealthCheckLog, inStartPerio
 	return ioutil.WriteFile(c.healthCheckLogPath(), newResults, 0700)
 }
 
+// healthCheckMaxLogCount returns the number of healthcheck results kept in
+// the healthcheck log of the container
+func (c *Container) healthCheckMaxLogCount() int {
+	if c.config.HealthMaxLogCount > 0 {
+		return int(c.config.HealthMaxLogCount)
+	}
+	return MaxHealthCheckNumberLogs
+}
+
+// healthCheckMaxLogSize returns the maximum length of the output of a
+// healthcheck kept in the healthcheck log of the container
+func (c *Container) healthCheckMaxLogSize() int {
+	if c.config.HealthMaxLogSize > 0 {
+		return int(c.config.HealthMaxLogSize)
+	}
+	return MaxHealthCheckLogLength
+}
+
 // HealthCheckLogPath returns the path for where the health check log is
 func (c *Container) healthCheckLogPath() string {
 	return filepath.Join(filepath.Dir(c.state.RunDir), "healthcheck.log")
@@ -243,10 +387,13 @@ func (c *Container) HealthCheckStatus() (string, error) {
 	return results.Status, nil
 }
 
-func (c *Container) disableHealthCheckSystemd() bool {
+func (c *Container) disableHealthCheckSystemd(isStartup bool) bool {
 	if os.Getenv("DISABLE_HC_SYSTEMD") == "true" {
 		return true
 	}
+	if isStartup {
+		return c.config.StartupHealthCheckConfig.Interval == 0
+	}
 	if c.config.HealthCheckConfig.Interval == 0 {
 		return true
 	}
@@ -8,15 +8,23 @@ import (
 
 	"github.com/containers/podman/v2/pkg/rootless"
 	"github.com/containers/podman/v2/pkg/systemd"
+	"github.com/containers/storage/pkg/stringid"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 )
 
-// createTimer systemd timers for healthchecks of a container
-func (c *Container) createTimer() error {
-	if c.disableHealthCheckSystemd() {
+// createTimer systemd timers for healthchecks of a container.  If isStartup
+// is set, the timer runs at the interval of the startup healthcheck.  The
+// name of the transient unit is recorded in the container state, which must
+// be saved by the caller.
+func (c *Container) createTimer(isStartup bool) error {
+	if c.disableHealthCheckSystemd(isStartup) {
 		return nil
 	}
+	interval := c.HealthCheckConfig().Interval
+	if isStartup {
+		interval = c.StartupHealthCheckConfig().Interval
+	}
 	podman, err := os.Executable()
 	if err != nil {
 		return errors.Wrapf(err, "failed to get path for podman for a health check timer")
@@ -30,7 +38,11 @@ func (c *Container) createTimer() error {
 	if path != "" {
 		cmd = append(cmd, "--setenv=PATH="+path)
 	}
-	cmd = append(cmd, "--unit", c.ID(), fmt.Sprintf("--on-unit-inactive=%s", c.HealthCheckConfig().Interval.String()), "--timer-property=AccuracySec=1s", podman, "healthcheck", "run", c.ID())
+	// Use a unique unit name, so a new timer can be created while the
+	// service of the previous one is still running (e.g., when a
+	// healthcheck restarts the container).
+	hcUnitName := fmt.Sprintf("%s-%s", c.ID(), stringid.GenerateNonCryptoID()[:16])
+	cmd = append(cmd, "--unit", hcUnitName, fmt.Sprintf("--on-unit-inactive=%s", interval.String()), "--timer-property=AccuracySec=1s", podman, "healthcheck", "run", c.ID())
 
 	conn, err := systemd.ConnectToDBUS()
 	if err != nil {
@@ -42,12 +54,13 @@ func (c *Container) createTimer() error {
 	if output, err := systemdRun.CombinedOutput(); err != nil {
 		return errors.Errorf("%s", output)
 	}
+	c.state.HCUnitName = hcUnitName
 	return nil
 }
 
 // startTimer starts a systemd timer for the healthchecks
-func (c *Container) startTimer() error {
-	if c.disableHealthCheckSystemd() {
+func (c *Container) startTimer(isStartup bool) error {
+	if c.disableHealthCheckSystemd(isStartup) {
 		return nil
 	}
 	conn, err := systemd.ConnectToDBUS()
@@ -55,14 +68,16 @@ func (c *Container) startTimer() error {
 		return errors.Wrapf(err, "unable to get systemd connection to start healthchecks")
 	}
 	defer conn.Close()
-	_, err = conn.StartUnit(fmt.Sprintf("%s.service", c.ID()), "fail", nil)
+	_, err = conn.StartUnit(fmt.Sprintf("%s.service", c.hcUnitName()), "fail", nil)
 	return err
 }
 
 // removeTimer removes the systemd timer and unit files
 // for the container
 func (c *Container) removeTimer() error {
-	if c.disableHealthCheckSystemd() {
+	// Without a recorded unit, a timer only exists if the regular
+	// healthcheck runs via systemd.
+	if c.state.HCUnitName == "" && c.disableHealthCheckSystemd(false) {
 		return nil
 	}
 	conn, err := systemd.ConnectToDBUS()
@@ -70,7 +85,7 @@ func (c *Container) removeTimer() error {
 		return errors.Wrapf(err, "unable to get systemd connection to remove healthchecks")
 	}
 	defer conn.Close()
-	timerFile := fmt.Sprintf("%s.timer", c.ID())
+	timerFile := fmt.Sprintf("%s.timer", c.hcUnitName())
 	_, err = conn.StopUnit(timerFile, "fail", nil)
 
 	// We want to ignore errors where the timer unit has already been removed. The error
@@ -80,3 +95,13 @@ func (c *Container) removeTimer() error {
 	}
 	return err
 }
+
+// hcUnitName returns the name of the transient unit running the healthchecks
+// of the container.  Containers started by older versions of Podman use the
+// ID of the container.
+func (c *Container) hcUnitName() string {
+	if c.state.HCUnitName != "" {
+		return c.state.HCUnitName
+	}
+	return c.ID()
+}
@@ -5,12 +5,12 @@ package libpod
 import "github.com/containers/podman/v2/libpod/define"
 
 // createTimer systemd timers for healthchecks of a container
-func (c *Container) createTimer() error {
+func (c *Container) createTimer(isStartup bool) error {
 	return define.ErrNotImplemented
 }
 
 // startTimer starts a systemd timer for the healthchecks
-func (c *Container) startTimer() error {
+func (c *Container) startTimer(isStartup bool) error {
 	return define.ErrNotImplemented
 }
 
@@ -5,11 +5,13 @@ import (
 	"fmt"
 
 	"github.com/containers/buildah/manifests"
+	buildahManifests "github.com/containers/buildah/pkg/manifests"
 	"github.com/containers/image/v5/docker"
 	"github.com/containers/image/v5/manifest"
 	"github.com/containers/image/v5/transports/alltransports"
 	"github.com/containers/image/v5/types"
 	"github.com/opencontainers/go-digest"
+	"github.com/pkg/errors"
 )
 
 // Options for adding a manifest
@@ -27,6 +29,7 @@ type ManifestAddOpts struct {
 
 // ManifestAnnotateOptions defines the options for
 // manifest annotate
+// swagger:model ManifestAnnotateOpts
 type ManifestAnnotateOpts struct {
 	Annotation map[string]string `json:"annotation"`
 	Arch       string            `json:"arch"`
@@ -46,6 +49,17 @@ func (i *Image) InspectManifest() (*manifest.Schema2List, error) {
 	return list.Docker(), nil
 }
 
+// IsManifestList returns true if the image is a manifest list or image index.
+func (i *Image) IsManifestList() (bool, error) {
+	if _, err := i.getManifestList(); err != nil {
+		if errors.Cause(err) == buildahManifests.ErrManifestTypeNotSupported {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
 // RemoveManifest removes the given digest from the manifest list.
 func (i *Image) RemoveManifest(d digest.Digest) (string, error) {
 	list, err := i.getManifestList()
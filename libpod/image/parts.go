@@ -31,6 +31,14 @@ func GetImageBaseName(input string) (string, error) {
 	return splitImageName[len(splitImageName)-1], nil
 }
 
+// IsFullyQualified returns true if input is a reference of an image that
+// includes the registry, so the image can be pulled without resolving a short
+// name.
+func IsFullyQualified(input string) bool {
+	decomposedImage, err := decompose(input)
+	return err == nil && decomposedImage.hasRegistry
+}
+
 // decompose breaks an input name into an imageParts description
 func decompose(input string) (imageParts, error) {
 	imgRef, err := reference.Parse(input)
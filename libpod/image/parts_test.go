@@ -53,6 +53,22 @@ func TestDecompose(t *testing.T) {
 	}
 }
 
+func TestIsFullyQualified(t *testing.T) {
+	for _, c := range []struct {
+		input    string
+		expected bool
+	}{
+		{"#", false},
+		{"busybox", false},
+		{"ns/busybox:notlatest", false},
+		{"docker.io/library/busybox", true},
+		{"localhost/busybox", true},
+		{"example.com:5000/ns/busybox:notlatest", true},
+	} {
+		assert.Equal(t, c.expected, IsFullyQualified(c.input), c.input)
+	}
+}
+
 func TestImagePartsReferenceWithRegistry(t *testing.T) {
 	const digestSuffix = "@sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
 
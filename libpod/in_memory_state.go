@@ -828,6 +828,50 @@ func (s *InMemoryState) RewriteContainerConfig(ctr *Container, newCfg *Container
 	return nil
 }
 
+// SafeRewriteContainerConfig rewrites a container's configuration, changing
+// its name or its resource limits.
+// Please read the full comment on it in state.go before using it.
+func (s *InMemoryState) SafeRewriteContainerConfig(ctr *Container, oldName, newName string, newCfg *ContainerConfig) error {
+	if !ctr.valid {
+		return define.ErrCtrRemoved
+	}
+
+	if newName == "" || newCfg.Name != newName {
+		return errors.Wrapf(define.ErrInvalidArg, "new name %q for container %s must match the name in the new configuration", newName, ctr.ID())
+	}
+
+	// If the container does not exist, return error
+	stateCtr, ok := s.containers[ctr.ID()]
+	if !ok {
+		ctr.valid = false
+		return errors.Wrapf(define.ErrNoSuchCtr, "container with ID %s not found in state", ctr.ID())
+	}
+
+	if oldName != newName {
+		if err := s.nameIndex.Reserve(newName, ctr.ID()); err != nil {
+			return errors.Wrapf(define.ErrCtrExists, "name %q is in use", newName)
+		}
+		s.nameIndex.Release(oldName)
+
+		if ctr.config.Namespace != "" {
+			nsIndex, ok := s.namespaceIndexes[ctr.config.Namespace]
+			if !ok {
+				return errors.Wrapf(define.ErrInternal, "error retrieving index for namespace %q", ctr.config.Namespace)
+			}
+			// Should be no errors here, the global name index
+			// already ensured the name is unique
+			if err := nsIndex.nameIndex.Reserve(newName, ctr.ID()); err != nil {
+				return errors.Wrapf(err, "error registering container name %s", newName)
+			}
+			nsIndex.nameIndex.Release(oldName)
+		}
+	}
+
+	stateCtr.config = newCfg
+
+	return nil
+}
+
 // RewritePodConfig rewrites a pod's configuration.
 // This function is DANGEROUS, even with in-memory state.
 // Please read the full comment on it in state.go before using it.
@@ -16,6 +16,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	v12 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -104,6 +105,40 @@ func (p *Pod) GenerateForKube() (*v1.Pod, []v1.ServicePort, error) {
 	return pod, servicePorts, nil
 }
 
+// GenerateForKube generates a v1.PersistentVolumeClaim description of the
+// volume. The driver and options of the volume are recorded as annotations.
+func (v *Volume) GenerateForKube() *v1.PersistentVolumeClaim {
+	annotations := make(map[string]string)
+	annotations[define.VolumeDriverAnnotation] = v.Driver()
+	for key, value := range v.Options() {
+		annotations[define.VolumeOptionAnnotationPrefix+key] = value
+	}
+
+	return &v1.PersistentVolumeClaim{
+		TypeMeta: v12.TypeMeta{
+			Kind:       "PersistentVolumeClaim",
+			APIVersion: "v1",
+		},
+		ObjectMeta: v12.ObjectMeta{
+			Name:              v.Name(),
+			Annotations:       annotations,
+			CreationTimestamp: v12.Now(),
+		},
+		Spec: v1.PersistentVolumeClaimSpec{
+			AccessModes: []v1.PersistentVolumeAccessMode{
+				v1.ReadWriteOnce,
+			},
+			// Kubernetes requires a size; podman volumes do not
+			// have one.
+			Resources: v1.ResourceRequirements{
+				Requests: v1.ResourceList{
+					v1.ResourceStorage: resource.MustParse("1Gi"),
+				},
+			},
+		},
+	}
+}
+
 func (p *Pod) getInfraContainer() (*Container, error) {
 	infraID, err := p.InfraContainerID()
 	if err != nil {
@@ -171,8 +206,10 @@ func (p *Pod) podWithContainers(containers []*Container, ports []v1.ContainerPor
 	deDupPodVolumes := make(map[string]*v1.Volume)
 	first := true
 	podContainers := make([]v1.Container, 0, len(containers))
+	podAnnotations := make(map[string]string)
 	for _, ctr := range containers {
 		if !ctr.IsInfra() {
+			addHealthCheckOnFailureAnnotation(podAnnotations, ctr)
 			ctr, volumes, err := containerToV1Container(ctr)
 			if err != nil {
 				return nil, err
@@ -203,10 +240,46 @@ func (p *Pod) podWithContainers(containers []*Container, ports []v1.ContainerPor
 		podVolumes = append(podVolumes, *vol)
 	}
 
-	return addContainersAndVolumesToPodObject(podContainers, podVolumes, p.Name()), nil
+	addPodResourceLimitsAnnotations(podAnnotations, p.config.ResourceLimits)
+
+	return addContainersAndVolumesToPodObject(podContainers, podVolumes, podAnnotations, p.Name()), nil
+}
+
+// addPodResourceLimitsAnnotations records the resource limits of the pod
+// cgroup in the specified pod annotations.
+func addPodResourceLimitsAnnotations(annotations map[string]string, res *specs.LinuxResources) {
+	if res == nil {
+		return
+	}
+	prefix := define.KubePodResourceLimitsAnnotation + "/"
+	if res.CPU != nil {
+		if res.CPU.Quota != nil && res.CPU.Period != nil && *res.CPU.Quota > 0 && *res.CPU.Period > 0 {
+			cpuLimitMilli := int64(1000 * util.PeriodAndQuotaToCores(*res.CPU.Period, *res.CPU.Quota))
+			annotations[prefix+string(v1.ResourceCPU)] = resource.NewMilliQuantity(cpuLimitMilli, resource.DecimalSI).String()
+		}
+		if res.CPU.Cpus != "" {
+			annotations[prefix+"cpuset"] = res.CPU.Cpus
+		}
+	}
+	if res.Memory != nil && res.Memory.Limit != nil && *res.Memory.Limit > 0 {
+		annotations[prefix+string(v1.ResourceMemory)] = resource.NewQuantity(*res.Memory.Limit, resource.BinarySI).String()
+	}
+	if res.Pids != nil && res.Pids.Limit > 0 {
+		annotations[prefix+"pids"] = strconv.FormatInt(res.Pids.Limit, 10)
+	}
+}
+
+// addHealthCheckOnFailureAnnotation records the healthcheck on-failure action
+// of the container in the specified pod annotations.
+func addHealthCheckOnFailureAnnotation(annotations map[string]string, ctr *Container) {
+	action := ctr.HealthCheckOnFailureAction()
+	if ctr.HealthCheckConfig() == nil || action == define.HealthCheckOnFailureActionNone {
+		return
+	}
+	annotations[define.KubeHealthCheckOnFailureAnnotation+"/"+removeUnderscores(ctr.Name())] = action.String()
 }
 
-func addContainersAndVolumesToPodObject(containers []v1.Container, volumes []v1.Volume, podName string) *v1.Pod {
+func addContainersAndVolumesToPodObject(containers []v1.Container, volumes []v1.Volume, annotations map[string]string, podName string) *v1.Pod {
 	tm := v12.TypeMeta{
 		Kind:       "Pod",
 		APIVersion: "v1",
@@ -224,6 +297,9 @@ func addContainersAndVolumesToPodObject(containers []v1.Container, volumes []v1.
 		// of the container create time to v1 Time is probably not warranted nor worthwhile.
 		CreationTimestamp: v12.Now(),
 	}
+	if len(annotations) > 0 {
+		om.Annotations = annotations
+	}
 	ps := v1.PodSpec{
 		Containers: containers,
 		Volumes:    volumes,
@@ -243,7 +319,9 @@ func simplePodWithV1Container(ctr *Container) (*v1.Pod, error) {
 	if err != nil {
 		return nil, err
 	}
-	return addContainersAndVolumesToPodObject([]v1.Container{kubeCtr}, kubeVols, ctr.Name()), nil
+	annotations := make(map[string]string)
+	addHealthCheckOnFailureAnnotation(annotations, ctr)
+	return addContainersAndVolumesToPodObject([]v1.Container{kubeCtr}, kubeVols, annotations, ctr.Name()), nil
 
 }
 
@@ -391,10 +469,9 @@ func libpodEnvVarsToKubeEnvVars(envs []string) ([]v1.EnvVar, error) {
 
 // libpodMountsToKubeVolumeMounts converts the containers mounts to a struct kube understands
 func libpodMountsToKubeVolumeMounts(c *Container) ([]v1.VolumeMount, []v1.Volume, error) {
-	// TjDO when named volumes are supported in play kube, also parse named volumes here
-	_, mounts := c.sortUserVolumes(c.config.Spec)
-	vms := make([]v1.VolumeMount, 0, len(mounts))
-	vos := make([]v1.Volume, 0, len(mounts))
+	namedVolumes, mounts := c.sortUserVolumes(c.config.Spec)
+	vms := make([]v1.VolumeMount, 0, len(mounts)+len(namedVolumes))
+	vos := make([]v1.Volume, 0, len(mounts)+len(namedVolumes))
 	for _, m := range mounts {
 		vm, vo, err := generateKubeVolumeMount(m)
 		if err != nil {
@@ -403,9 +480,39 @@ func libpodMountsToKubeVolumeMounts(c *Container) ([]v1.VolumeMount, []v1.Volume
 		vms = append(vms, vm)
 		vos = append(vos, vo)
 	}
+	for _, v := range namedVolumes {
+		vm, vo := generateKubePersistentVolumeClaim(v)
+		vms = append(vms, vm)
+		vos = append(vos, vo)
+	}
 	return vms, vos, nil
 }
 
+// generateKubePersistentVolumeClaim takes a named volume and returns a
+// kubernetes VolumeMount (to be added to the container) and a kubernetes
+// Volume referring to a PersistentVolumeClaim of the same name (to be added
+// to the pod)
+func generateKubePersistentVolumeClaim(v *ContainerNamedVolume) (v1.VolumeMount, v1.Volume) {
+	// Kubernetes volume names must not contain underscores. The suffix
+	// avoids clashes with the names generated for bind mounts.
+	name := removeUnderscores(v.Name) + "-pvc"
+
+	vm := v1.VolumeMount{
+		Name:      name,
+		MountPath: v.Dest,
+		ReadOnly:  util.StringInSlice("ro", v.Options),
+	}
+	vo := v1.Volume{
+		Name: name,
+		VolumeSource: v1.VolumeSource{
+			PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+				ClaimName: v.Name,
+			},
+		},
+	}
+	return vm, vo
+}
+
 // generateKubeVolumeMount takes a user specified mount and returns
 // a kubernetes VolumeMount (to be added to the container) and a kubernetes Volume
 // (to be added to the pod)
@@ -27,6 +27,12 @@ const (
 
 	// FullLogType signifies a log line is full
 	FullLogType = "F"
+
+	// StdoutStream is the stream of log lines written to stdout
+	StdoutStream = "stdout"
+
+	// StderrStream is the stream of log lines written to stderr
+	StderrStream = "stderr"
 )
 
 // LogOptions is the options you can use for logs
@@ -34,6 +40,8 @@ type LogOptions struct {
 	Details    bool
 	Follow     bool
 	Since      time.Time
+	Until      time.Time
+	Stream     string
 	Tail       int64
 	Timestamps bool
 	Multi      bool
@@ -51,7 +59,9 @@ type LogLine struct {
 	CName        string
 }
 
-// GetLogFile returns an hp tail for a container given options
+// GetLogFile returns an hp tail for a container given options.  The returned
+// log lines precede the lines of the tail and include the lines of the
+// rotated generations of the log file, if needed.
 func GetLogFile(path string, options *LogOptions) (*tail.Tail, []*LogLine, error) {
 	var (
 		whence  int
@@ -67,6 +77,12 @@ func GetLogFile(path string, options *LogOptions) (*tail.Tail, []*LogLine, error
 		if err != nil {
 			return nil, nil, err
 		}
+	} else if options.Tail < 0 {
+		// The whole log is read, starting with the rotated generations.
+		logTail, err = getRotatedLogs(path)
+		if err != nil {
+			return nil, nil, err
+		}
 	}
 	seek := tail.SeekInfo{
 		Offset: 0,
@@ -158,6 +174,20 @@ func getTailLog(path string, tail int) ([]*LogLine, error) {
 			break
 		}
 	}
+
+	// Continue with the rotated generations, most recent first, if the
+	// current log file does not hold enough lines.
+	rotated := RotatedLogFiles(path)
+	for i := 0; i < len(rotated) && len(tailLog) < tail; i++ {
+		lines, err := readRotatedLogFile(rotated[i])
+		if err != nil {
+			return nil, err
+		}
+		if missing := tail - len(tailLog); len(lines) > missing {
+			lines = lines[len(lines)-missing:]
+		}
+		tailLog = append(lines, tailLog...)
+	}
 	return tailLog, nil
 }
 
@@ -187,6 +217,34 @@ func (l *LogLine) Since(since time.Time) bool {
 	return l.Time.After(since)
 }
 
+// Until returns a bool as to whether a log line occurred before a given time.
+// A zero time matches all log lines.
+func (l *LogLine) Until(until time.Time) bool {
+	return until.IsZero() || !l.Time.After(until)
+}
+
+// FromStream returns a bool as to whether a log line was written to the given
+// stream.  An empty stream matches all log lines.
+func (l *LogLine) FromStream(stream string) bool {
+	return stream == "" || l.Device == stream
+}
+
+// Matches returns a bool as to whether a log line passes the time and stream
+// filters of the given options.
+func (l *LogLine) Matches(options *LogOptions) bool {
+	return l.Since(options.Since) && l.Until(options.Until) && l.FromStream(options.Stream)
+}
+
+// ValidateStream returns an error if the given stream cannot be used to filter
+// log lines.
+func ValidateStream(stream string) error {
+	switch stream {
+	case "", StdoutStream, StderrStream:
+		return nil
+	}
+	return errors.Errorf("invalid log stream %q: must be %q or %q", stream, StdoutStream, StderrStream)
+}
+
 // NewLogLine creates a logLine struct from a container log string
 func NewLogLine(line string) (*LogLine, error) {
 	splitLine := strings.Split(line, " ")
@@ -0,0 +1,41 @@
+package logs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogLineFilters(t *testing.T) {
+	line, err := NewLogLine("2020-10-20T10:00:00.000000000+00:00 stderr F error message")
+	require.NoError(t, err)
+	assert.Equal(t, StderrStream, line.Device)
+	assert.Equal(t, "error message", line.Msg)
+
+	before := line.Time.Add(-time.Minute)
+	after := line.Time.Add(time.Minute)
+
+	assert.True(t, line.Until(time.Time{}))
+	assert.True(t, line.Until(after))
+	assert.True(t, line.Until(line.Time))
+	assert.False(t, line.Until(before))
+
+	assert.True(t, line.FromStream(""))
+	assert.True(t, line.FromStream(StderrStream))
+	assert.False(t, line.FromStream(StdoutStream))
+
+	assert.True(t, line.Matches(&LogOptions{}))
+	assert.True(t, line.Matches(&LogOptions{Since: before, Until: after, Stream: StderrStream}))
+	assert.False(t, line.Matches(&LogOptions{Since: after}))
+	assert.False(t, line.Matches(&LogOptions{Until: before}))
+	assert.False(t, line.Matches(&LogOptions{Stream: StdoutStream}))
+}
+
+func TestValidateStream(t *testing.T) {
+	for _, stream := range []string{"", StdoutStream, StderrStream} {
+		assert.NoError(t, ValidateStream(stream))
+	}
+	assert.Error(t, ValidateStream("stdin"))
+}
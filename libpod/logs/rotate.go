@@ -0,0 +1,187 @@
+package logs
+
+import (
+	"bufio"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// compressedSuffix is the suffix of compressed rotated log files.
+const compressedSuffix = ".gz"
+
+// rotatedLogFile returns the path of the given generation of the rotated log
+// file. Generation 1 is the most recent one.
+func rotatedLogFile(path string, generation uint) string {
+	return fmt.Sprintf("%s.%d", path, generation)
+}
+
+// existingRotatedLogFile returns the path of the given generation of the
+// rotated log file, which may be compressed. An empty string is returned if
+// the generation does not exist.
+func existingRotatedLogFile(path string, generation uint) string {
+	rotated := rotatedLogFile(path, generation)
+	for _, p := range []string{rotated, rotated + compressedSuffix} {
+		if _, err := os.Stat(p); err == nil {
+			return p
+		}
+	}
+	return ""
+}
+
+// RotatedLogFiles returns the paths of the existing rotated generations of
+// the log file at path, most recent first.
+func RotatedLogFiles(path string) []string {
+	var files []string
+	for generation := uint(1); ; generation++ {
+		rotated := existingRotatedLogFile(path, generation)
+		if rotated == "" {
+			return files
+		}
+		files = append(files, rotated)
+	}
+}
+
+// RotateLogFile rotates the log file at path, keeping at most maxFiles files
+// including the current one. The current log file becomes generation 1 and
+// the oldest generation is removed. If compress is set, all generations but
+// the most recent one are compressed; the most recent one is left
+// uncompressed as the logging process may still write to it until it
+// reopens the log file.
+func RotateLogFile(path string, maxFiles uint, compress bool) error {
+	if maxFiles < 2 {
+		return errors.Errorf("log rotation requires at least 2 files, got %d", maxFiles)
+	}
+
+	// Remove the oldest generation, which is about to exceed maxFiles.
+	for _, p := range []string{rotatedLogFile(path, maxFiles-1), rotatedLogFile(path, maxFiles-1) + compressedSuffix} {
+		if err := os.Remove(p); err != nil && !os.IsNotExist(err) {
+			return errors.Wrapf(err, "error removing rotated log file %s", p)
+		}
+	}
+
+	for generation := maxFiles - 2; generation > 0; generation-- {
+		from := existingRotatedLogFile(path, generation)
+		if from == "" {
+			continue
+		}
+		to := rotatedLogFile(path, generation+1)
+		if strings.HasSuffix(from, compressedSuffix) {
+			to += compressedSuffix
+		}
+		if err := os.Rename(from, to); err != nil {
+			return errors.Wrapf(err, "error rotating log file %s", from)
+		}
+		if compress && !strings.HasSuffix(to, compressedSuffix) {
+			if err := compressLogFile(to); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := os.Rename(path, rotatedLogFile(path, 1)); err != nil {
+		return errors.Wrapf(err, "error rotating log file %s", path)
+	}
+	return nil
+}
+
+// compressLogFile replaces the file at path with a gzip compressed copy.
+func compressLogFile(path string) (retErr error) {
+	src, err := os.Open(path)
+	if err != nil {
+		return errors.Wrapf(err, "error opening log file %s for compression", path)
+	}
+	defer src.Close()
+
+	compressed := path + compressedSuffix
+	dst, err := os.OpenFile(compressed, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0640)
+	if err != nil {
+		return errors.Wrapf(err, "error creating compressed log file %s", compressed)
+	}
+	defer func() {
+		if retErr != nil {
+			os.Remove(compressed)
+		}
+	}()
+
+	writer := gzip.NewWriter(dst)
+	if _, err := io.Copy(writer, src); err != nil {
+		dst.Close()
+		return errors.Wrapf(err, "error compressing log file %s", path)
+	}
+	if err := writer.Close(); err != nil {
+		dst.Close()
+		return errors.Wrapf(err, "error compressing log file %s", path)
+	}
+	if err := dst.Close(); err != nil {
+		return errors.Wrapf(err, "error compressing log file %s", path)
+	}
+	return os.Remove(path)
+}
+
+// readRotatedLogFile reads all log lines of a rotated, possibly compressed,
+// log file. Partial lines are joined with the following full line.
+func readRotatedLogFile(path string) ([]*LogLine, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var reader io.Reader = f
+	if strings.HasSuffix(path, compressedSuffix) {
+		gz, err := gzip.NewReader(f)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error reading compressed log file %s", path)
+		}
+		defer gz.Close()
+		reader = gz
+	}
+
+	var (
+		lines   []*LogLine
+		partial string
+	)
+	buffered := bufio.NewReader(reader)
+	for {
+		line, err := buffered.ReadString('\n')
+		if line = strings.TrimSuffix(line, "\n"); len(line) > 0 {
+			nll, parseErr := NewLogLine(line)
+			if parseErr != nil {
+				return nil, parseErr
+			}
+			if nll.Partial() {
+				partial += nll.Msg
+			} else {
+				nll.Msg = partial + nll.Msg
+				partial = ""
+				lines = append(lines, nll)
+			}
+		}
+		if err == io.EOF {
+			return lines, nil
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "error reading log file %s", path)
+		}
+	}
+}
+
+// getRotatedLogs returns the log lines of all rotated generations of the log
+// file at path, oldest first.
+func getRotatedLogs(path string) ([]*LogLine, error) {
+	var lines []*LogLine
+	rotated := RotatedLogFiles(path)
+	for i := len(rotated) - 1; i >= 0; i-- {
+		generation, err := readRotatedLogFile(rotated[i])
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, generation...)
+	}
+	return lines, nil
+}
@@ -0,0 +1,102 @@
+package logs
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeLogLines(t *testing.T, path string, msgs ...string) {
+	var b strings.Builder
+	for _, msg := range msgs {
+		fmt.Fprintf(&b, "2020-10-20T10:00:00.000000000+00:00 stdout F %s\n", msg)
+	}
+	require.NoError(t, ioutil.WriteFile(path, []byte(b.String()), 0644))
+}
+
+func logMessages(lines []*LogLine) []string {
+	msgs := []string{}
+	for _, line := range lines {
+		msgs = append(msgs, line.Msg)
+	}
+	return msgs
+}
+
+func TestRotateLogFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "logrotate")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "ctr.log")
+
+	for i := 0; i < 4; i++ {
+		writeLogLines(t, path, fmt.Sprintf("line%d", i))
+		require.NoError(t, RotateLogFile(path, 3, false))
+	}
+
+	assert.Equal(t, []string{path + ".1", path + ".2"}, RotatedLogFiles(path))
+	_, err = os.Stat(path)
+	assert.True(t, os.IsNotExist(err))
+
+	lines, err := getRotatedLogs(path)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"line2", "line3"}, logMessages(lines))
+}
+
+func TestRotateLogFileCompress(t *testing.T) {
+	dir, err := ioutil.TempDir("", "logrotate")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "ctr.log")
+
+	for i := 0; i < 3; i++ {
+		writeLogLines(t, path, fmt.Sprintf("line%d", i))
+		require.NoError(t, RotateLogFile(path, 4, true))
+	}
+
+	// The most recent generation is never compressed.
+	assert.Equal(t, []string{path + ".1", path + ".2" + compressedSuffix, path + ".3" + compressedSuffix}, RotatedLogFiles(path))
+
+	lines, err := getRotatedLogs(path)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"line0", "line1", "line2"}, logMessages(lines))
+}
+
+func TestRotateLogFileInvalid(t *testing.T) {
+	assert.Error(t, RotateLogFile("/does/not/matter", 1, false))
+}
+
+func TestGetLogFileRotated(t *testing.T) {
+	dir, err := ioutil.TempDir("", "logrotate")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "ctr.log")
+
+	writeLogLines(t, path, "a", "b")
+	require.NoError(t, RotateLogFile(path, 3, true))
+	writeLogLines(t, path, "c", "d")
+	require.NoError(t, RotateLogFile(path, 3, true))
+	writeLogLines(t, path, "e", "f")
+
+	// All lines, the rotated ones precede the tailed file.
+	tailer, lines, err := GetLogFile(path, &LogOptions{Tail: -1})
+	require.NoError(t, err)
+	tailer.Kill(nil)
+	assert.Equal(t, []string{"a", "b", "c", "d"}, logMessages(lines))
+
+	// The tail is read across the rotated files.
+	tailer, lines, err = GetLogFile(path, &LogOptions{Tail: 5})
+	require.NoError(t, err)
+	tailer.Kill(nil)
+	assert.Equal(t, []string{"b", "c", "d", "e", "f"}, logMessages(lines))
+
+	tailer, lines, err = GetLogFile(path, &LogOptions{Tail: 1})
+	require.NoError(t, err)
+	tailer.Kill(nil)
+	assert.Equal(t, []string{"f"}, logMessages(lines))
+}
@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	"github.com/containers/podman/v2/libpod/define"
+	spec "github.com/opencontainers/runtime-spec/specs-go"
 	"k8s.io/client-go/tools/remotecommand"
 )
 
@@ -49,6 +50,10 @@ type OCIRuntime interface {
 	PauseContainer(ctr *Container) error
 	// UnpauseContainer unpauses the given container.
 	UnpauseContainer(ctr *Container) error
+	// UpdateContainerResources updates the resource limits of the given
+	// running or paused container. Only the limits set in resources are
+	// changed.
+	UpdateContainerResources(ctr *Container, resources *spec.LinuxResources) error
 
 	// HTTPAttach performs an attach intended to be transported over HTTP.
 	// For terminal attach, the container's output will be directly streamed
@@ -65,6 +70,9 @@ type OCIRuntime interface {
 	HTTPAttach(ctr *Container, r *http.Request, w http.ResponseWriter, streams *HTTPAttachStreams, detachKeys *string, cancel <-chan bool, hijackDone chan<- bool, streamAttach, streamLogs bool) error
 	// AttachResize resizes the terminal in use by the given container.
 	AttachResize(ctr *Container, newSize remotecommand.TerminalSize) error
+	// ReopenContainerLog instructs the runtime to reopen the log file of
+	// the given running container, e.g., after it has been rotated.
+	ReopenContainerLog(ctr *Container) error
 
 	// ExecContainer executes a command in a running container.
 	// Returns an int (PID of exec session), error channel (errors from
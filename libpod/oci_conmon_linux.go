@@ -497,6 +497,39 @@ func (r *ConmonOCIRuntime) UnpauseContainer(ctr *Container) error {
 	return utils.ExecCmdWithStdStreams(os.Stdin, os.Stdout, os.Stderr, env, r.path, append(r.runtimeFlags, "resume", ctr.ID())...)
 }
 
+// UpdateContainerResources updates the resource limits of the given container
+// using the update command of the OCI runtime.
+func (r *ConmonOCIRuntime) UpdateContainerResources(ctr *Container, resources *spec.LinuxResources) error {
+	resourcesJSON, err := json.Marshal(resources)
+	if err != nil {
+		return errors.Wrapf(err, "error marshalling resource limits of container %s", ctr.ID())
+	}
+	resourcesFile, err := ioutil.TempFile(ctr.bundlePath(), "resources-")
+	if err != nil {
+		return errors.Wrapf(err, "error creating resource limits file for container %s", ctr.ID())
+	}
+	defer os.Remove(resourcesFile.Name())
+	if _, err := resourcesFile.Write(resourcesJSON); err != nil {
+		resourcesFile.Close()
+		return errors.Wrapf(err, "error writing resource limits file for container %s", ctr.ID())
+	}
+	if err := resourcesFile.Close(); err != nil {
+		return errors.Wrapf(err, "error writing resource limits file for container %s", ctr.ID())
+	}
+
+	runtimeDir, err := util.GetRuntimeDir()
+	if err != nil {
+		return err
+	}
+	args := append(r.runtimeFlags, "update", "--resources", resourcesFile.Name(), ctr.ID())
+	cmd := exec.Command(r.path, args...)
+	cmd.Env = append(os.Environ(), fmt.Sprintf("XDG_RUNTIME_DIR=%s", runtimeDir))
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return errors.Wrapf(err, "error updating resource limits of container %s: %s", ctr.ID(), strings.TrimSpace(string(out)))
+	}
+	return nil
+}
+
 // HTTPAttach performs an attach for the HTTP API.
 // The caller must handle closing the HTTP connection after this returns.
 // The cancel channel is not closed; it is up to the caller to do so after
@@ -754,6 +787,23 @@ func (r *ConmonOCIRuntime) AttachResize(ctr *Container, newSize remotecommand.Te
 	return nil
 }
 
+// ReopenContainerLog instructs conmon to reopen the log file of the given
+// container, e.g., after it has been rotated.
+func (r *ConmonOCIRuntime) ReopenContainerLog(ctr *Container) error {
+	controlFile, err := openControlFile(ctr, ctr.bundlePath())
+	if err != nil {
+		return err
+	}
+	defer controlFile.Close()
+
+	logrus.Debugf("Reopening log file of container %s", ctr.ID())
+	if _, err = fmt.Fprintf(controlFile, "%d %d %d\n", 2, 0, 0); err != nil {
+		return errors.Wrapf(err, "failed to write to ctl file to reopen log file")
+	}
+
+	return nil
+}
+
 // CheckpointContainer checkpoints the given container.
 func (r *ConmonOCIRuntime) CheckpointContainer(ctr *Container, options ContainerCheckpointOptions) error {
 	if err := label.SetSocketLabel(ctr.ProcessLabel()); err != nil {
@@ -761,6 +811,14 @@ func (r *ConmonOCIRuntime) CheckpointContainer(ctr *Container, options Container
 	}
 	// imagePath is used by CRIU to store the actual checkpoint files
 	imagePath := ctr.CheckpointPath()
+	preCheckPoints, err := ctr.preCheckPointCount()
+	if err != nil {
+		return err
+	}
+	if options.PreCheckPoint {
+		// Every pre-checkpoint is written to a directory of its own
+		imagePath = filepath.Join(ctr.PreCheckPointPath(), strconv.Itoa(preCheckPoints+1))
+	}
 	// workPath will be used to store dump.log and stats-dump
 	workPath := ctr.bundlePath()
 	logrus.Debugf("Writing checkpoint to %s", imagePath)
@@ -778,6 +836,18 @@ func (r *ConmonOCIRuntime) CheckpointContainer(ctr *Container, options Container
 	if options.TCPEstablished {
 		args = append(args, "--tcp-established")
 	}
+	if options.PreCheckPoint {
+		args = append(args, "--pre-dump")
+	}
+	if options.WithPrevious && preCheckPoints > 0 {
+		// CRIU expects the path to the previous pre-checkpoint to be
+		// relative to imagePath
+		parentPath, err := filepath.Rel(imagePath, filepath.Join(ctr.PreCheckPointPath(), strconv.Itoa(preCheckPoints)))
+		if err != nil {
+			return err
+		}
+		args = append(args, "--parent-path", parentPath)
+	}
 	runtimeDir, err := util.GetRuntimeDir()
 	if err != nil {
 		return err
@@ -1353,6 +1423,11 @@ func (r *ConmonOCIRuntime) sharedConmonArgs(ctr *Container, cuuid, bundlePath, p
 	if ctr.config.LogSize > 0 {
 		size = ctr.config.LogSize
 	}
+	// Rotated logs are rotated by libpod, conmon only truncates them as a
+	// backstop once they grew well beyond the size.
+	if ctr.logRotation() {
+		size *= logRotationBackstop
+	}
 	if size > 0 {
 		args = append(args, "--log-size-max", fmt.Sprintf("%v", size))
 	}
@@ -7,6 +7,7 @@ import (
 	"sync"
 
 	"github.com/containers/podman/v2/libpod/define"
+	spec "github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"k8s.io/client-go/tools/remotecommand"
@@ -104,6 +105,11 @@ func (r *MissingRuntime) PauseContainer(ctr *Container) error {
 	return r.printError()
 }
 
+// UpdateContainerResources is not available as the runtime is missing
+func (r *MissingRuntime) UpdateContainerResources(ctr *Container, resources *spec.LinuxResources) error {
+	return r.printError()
+}
+
 // UnpauseContainer is not available as the runtime is missing
 func (r *MissingRuntime) UnpauseContainer(ctr *Container) error {
 	return r.printError()
@@ -119,6 +125,11 @@ func (r *MissingRuntime) AttachResize(ctr *Container, newSize remotecommand.Term
 	return r.printError()
 }
 
+// ReopenContainerLog is not available as the runtime is missing
+func (r *MissingRuntime) ReopenContainerLog(ctr *Container) error {
+	return r.printError()
+}
+
 // ExecContainer is not available as the runtime is missing
 func (r *MissingRuntime) ExecContainer(ctr *Container, sessionID string, options *ExecOptions, streams *define.AttachStreams) (int, chan error, error) {
 	return -1, nil, r.printError()
@@ -14,10 +14,12 @@ import (
 	"github.com/containers/podman/v2/libpod/events"
 	"github.com/containers/podman/v2/pkg/namespaces"
 	"github.com/containers/podman/v2/pkg/rootless"
+	"github.com/containers/podman/v2/pkg/secrets"
 	"github.com/containers/podman/v2/pkg/util"
 	"github.com/containers/storage"
 	"github.com/containers/storage/pkg/idtools"
 	"github.com/cri-o/ocicni/pkg/ocicni"
+	spec "github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/opencontainers/runtime-tools/generate"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -529,6 +531,20 @@ func WithRuntimeFlags(runtimeFlags []string) RuntimeOption {
 
 // Container Creation Options
 
+// WithLogRotation sets the number of log files kept when the container log
+// is rotated once it reaches its maximum size, and whether rotated log files
+// are compressed.
+func WithLogRotation(maxFiles uint, compress bool) CtrCreateOption {
+	return func(ctr *Container) error {
+		if ctr.valid {
+			return define.ErrCtrFinalized
+		}
+		ctr.config.LogMaxFiles = maxFiles
+		ctr.config.LogCompress = compress
+		return nil
+	}
+}
+
 // WithMaxLogSize sets the maximum size of container logs.
 // Positive sizes are limits in bytes, -1 is unlimited.
 func WithMaxLogSize(limit int64) CtrCreateOption {
@@ -1469,6 +1485,45 @@ func WithHealthCheck(healthCheck *manifest.Schema2HealthConfig) CtrCreateOption
 	}
 }
 
+// WithStartupHealthCheck adds a startup healthcheck to the container config.
+// It runs until it succeeds once, after which the regular healthcheck takes
+// over.
+func WithStartupHealthCheck(startupHealthCheck *manifest.Schema2HealthConfig) CtrCreateOption {
+	return func(ctr *Container) error {
+		if ctr.valid {
+			return define.ErrCtrFinalized
+		}
+		ctr.config.StartupHealthCheckConfig = startupHealthCheck
+		return nil
+	}
+}
+
+// WithHealthCheckLogRetention sets the number of healthcheck results kept in
+// the healthcheck log and the maximum length of their output. 0 uses the
+// respective default.
+func WithHealthCheckLogRetention(maxLogCount, maxLogSize uint) CtrCreateOption {
+	return func(ctr *Container) error {
+		if ctr.valid {
+			return define.ErrCtrFinalized
+		}
+		ctr.config.HealthMaxLogCount = maxLogCount
+		ctr.config.HealthMaxLogSize = maxLogSize
+		return nil
+	}
+}
+
+// WithHealthCheckOnFailureAction sets the action to take once the container
+// turns unhealthy.
+func WithHealthCheckOnFailureAction(action define.HealthCheckOnFailureAction) CtrCreateOption {
+	return func(ctr *Container) error {
+		if ctr.valid {
+			return define.ErrCtrFinalized
+		}
+		ctr.config.HealthCheckOnFailureAction = action
+		return nil
+	}
+}
+
 // WithPreserveFDs forwards from the process running Libpod into the container
 // the given number of extra FDs (starting after the standard streams) to the created container
 func WithPreserveFDs(fd uint) CtrCreateOption {
@@ -1520,6 +1575,63 @@ func WithNetworkAliases(aliases map[string][]string) CtrCreateOption {
 	}
 }
 
+// WithSecrets adds secrets to the container. The secrets are looked up by
+// name, ID or partial ID, and each one is mounted at /run/secrets/<name>.
+func WithSecrets(secretNames []string) CtrCreateOption {
+	return func(ctr *Container) error {
+		if ctr.valid {
+			return define.ErrCtrFinalized
+		}
+
+		manager, err := ctr.runtime.SecretsManager()
+		if err != nil {
+			return err
+		}
+		for _, name := range secretNames {
+			secret, err := manager.Lookup(name)
+			if err != nil {
+				return err
+			}
+			for _, s := range ctr.config.Secrets {
+				if s.ID == secret.ID {
+					return errors.Wrapf(define.ErrInvalidArg, "secret %s was specified more than once", name)
+				}
+			}
+			ctr.config.Secrets = append(ctr.config.Secrets, secret)
+		}
+
+		return nil
+	}
+}
+
+// WithEnvSecrets adds secrets to the container that are exposed as
+// environment variables. Accepts a map of variable name to the name, ID or
+// partial ID of the secret.
+func WithEnvSecrets(envSecrets map[string]string) CtrCreateOption {
+	return func(ctr *Container) error {
+		if ctr.valid {
+			return define.ErrCtrFinalized
+		}
+
+		manager, err := ctr.runtime.SecretsManager()
+		if err != nil {
+			return err
+		}
+		if ctr.config.EnvSecrets == nil {
+			ctr.config.EnvSecrets = make(map[string]*secrets.Secret)
+		}
+		for target, name := range envSecrets {
+			secret, err := manager.Lookup(name)
+			if err != nil {
+				return err
+			}
+			ctr.config.EnvSecrets[target] = secret
+		}
+
+		return nil
+	}
+}
+
 // Volume Creation Options
 
 // WithVolumeName sets the name of the volume.
@@ -1540,19 +1652,15 @@ func WithVolumeName(name string) VolumeCreateOption {
 }
 
 // WithVolumeDriver sets the volume's driver.
-// It is presently not implemented, but will be supported in a future Podman
-// release.
+// Drivers other than the local driver must be volume plugins configured in
+// containers.conf; this is verified when the volume is created.
 func WithVolumeDriver(driver string) VolumeCreateOption {
 	return func(volume *Volume) error {
 		if volume.valid {
 			return define.ErrVolumeFinalized
 		}
-		// only local driver is possible rn
-		if driver != define.VolumeDriverLocal {
-			return define.ErrNotImplemented
 
-		}
-		volume.config.Driver = define.VolumeDriverLocal
+		volume.config.Driver = driver
 		return nil
 	}
 }
@@ -1574,8 +1682,9 @@ func WithVolumeLabels(labels map[string]string) VolumeCreateOption {
 }
 
 // WithVolumeOptions sets the options of the volume.
-// If the "local" driver has been selected, options will be validated. There are
-// currently 3 valid options for the "local" driver - o, type, and device.
+// If the "local" driver has been selected, options will be validated when the
+// volume is created. There are currently 3 valid options for the "local"
+// driver - o, type, and device. Volume plugins accept arbitrary options.
 func WithVolumeOptions(options map[string]string) VolumeCreateOption {
 	return func(volume *Volume) error {
 		if volume.valid {
@@ -1584,13 +1693,6 @@ func WithVolumeOptions(options map[string]string) VolumeCreateOption {
 
 		volume.config.Options = make(map[string]string)
 		for key, value := range options {
-			switch key {
-			case "type", "device", "o":
-				volume.config.Options[key] = value
-			default:
-				return errors.Wrapf(define.ErrInvalidArg, "unrecognized volume option %q is not supported with local driver", key)
-			}
-
 			volume.config.Options[key] = value
 		}
 
@@ -1827,6 +1929,21 @@ func WithPodCgroups() PodCreateOption {
 	}
 }
 
+// WithPodResourceLimits sets resource limits on the cgroup created for this
+// pod. The limits are shared by all containers in the pod.
+// Requires WithPodCgroups.
+func WithPodResourceLimits(resources *spec.LinuxResources) PodCreateOption {
+	return func(pod *Pod) error {
+		if pod.valid {
+			return define.ErrPodFinalized
+		}
+
+		pod.config.ResourceLimits = resources
+
+		return nil
+	}
+}
+
 // WithPodNamespace sets the namespace for the created pod.
 // Namespaces are used to create separate views of Podman's state - runtimes can
 // join a specific namespace and see only containers and pods in that namespace.
@@ -0,0 +1,87 @@
+package plugin
+
+// The types in this file mirror the JSON objects of the Docker volume plugin
+// protocol, as described in
+// https://docs.docker.com/engine/extend/plugins_volume/
+
+// activateResponse is the response from the activation endpoint of a
+// plugin.
+type activateResponse struct {
+	Implements []string
+}
+
+// CreateRequest is sent to a volume plugin to create a volume.
+type CreateRequest struct {
+	Name    string
+	Options map[string]string `json:"Opts,omitempty"`
+}
+
+// RemoveRequest is sent to a volume plugin to remove a volume.
+type RemoveRequest struct {
+	Name string
+}
+
+// PathRequest is sent to a volume plugin to retrieve the host path of a
+// volume.
+type PathRequest struct {
+	Name string
+}
+
+// MountRequest is sent to a volume plugin to mount a volume. ID is a unique
+// identifier of the caller requesting the mount.
+type MountRequest struct {
+	Name string
+	ID   string
+}
+
+// UnmountRequest is sent to a volume plugin to unmount a volume. ID must
+// match the ID of the corresponding MountRequest.
+type UnmountRequest struct {
+	Name string
+	ID   string
+}
+
+// GetRequest is sent to a volume plugin to retrieve a single volume.
+type GetRequest struct {
+	Name string
+}
+
+// Volume is a volume as reported by a volume plugin.
+type Volume struct {
+	Name       string
+	Mountpoint string                 `json:",omitempty"`
+	CreatedAt  string                 `json:",omitempty"`
+	Status     map[string]interface{} `json:",omitempty"`
+}
+
+// Capability is the set of capabilities of a volume plugin.
+type Capability struct {
+	// Scope is either "local" or "global".
+	Scope string
+}
+
+// errorResponse is the error part of every volume plugin response. An empty
+// Err indicates success.
+type errorResponse struct {
+	Err string
+}
+
+// pathResponse is the response to both path and mount requests.
+type pathResponse struct {
+	Mountpoint string
+	errorResponse
+}
+
+type getResponse struct {
+	Volume *Volume
+	errorResponse
+}
+
+type listResponse struct {
+	Volumes []*Volume
+	errorResponse
+}
+
+type capabilitiesResponse struct {
+	Capabilities Capability
+}
@@ -0,0 +1,360 @@
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/containers/podman/v2/libpod/define"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	defaultTimeout   = 5 * time.Second
+	volumePluginType = "VolumeDriver"
+	contentType      = "application/vnd.docker.plugins.v1.2+json"
+)
+
+var (
+	// ErrNotPlugin indicates that the socket does not belong to a plugin.
+	ErrNotPlugin = errors.New("target does not appear to be a valid plugin")
+	// ErrNotVolumePlugin indicates that the plugin does not implement the
+	// volume plugin API.
+	ErrNotVolumePlugin = errors.New("plugin is not a volume plugin")
+	// ErrPluginRemoved indicates that the plugin's socket is gone.
+	ErrPluginRemoved = errors.New("plugin is no longer available (shut down?)")
+
+	// This stores available, initialized volume plugins.
+	pluginsLock sync.Mutex
+	plugins     map[string]*VolumePlugin
+)
+
+const (
+	activatePath           = "/Plugin.Activate"
+	volumeCreatePath       = "/VolumeDriver.Create"
+	volumeListPath         = "/VolumeDriver.List"
+	volumeGetPath          = "/VolumeDriver.Get"
+	volumeRemovePath       = "/VolumeDriver.Remove"
+	volumeHostPathPath     = "/VolumeDriver.Path"
+	volumeMountPath        = "/VolumeDriver.Mount"
+	volumeUnmountPath      = "/VolumeDriver.Unmount"
+	volumeCapabilitiesPath = "/VolumeDriver.Capabilities"
+)
+
+// VolumePlugin is a single volume plugin.
+type VolumePlugin struct {
+	// Name is the name of the volume plugin. This will be used to refer to
+	// it.
+	Name string
+	// SocketPath is the unix socket at which the plugin is accessed.
+	SocketPath string
+	// Client is the HTTP client we use to connect to the plugin.
+	Client *http.Client
+}
+
+// GetVolumePlugin gets a single volume plugin, with the given name, at the
+// given path. Plugins are validated on first use and cached afterwards.
+func GetVolumePlugin(name string, path string) (*VolumePlugin, error) {
+	pluginsLock.Lock()
+	defer pluginsLock.Unlock()
+
+	plugin, exists := plugins[name]
+	if exists {
+		if plugin.SocketPath != filepath.Clean(path) {
+			return nil, errors.Wrapf(define.ErrInvalidArg, "requested path %q for volume plugin %s does not match pre-existing path for plugin, %q", path, name, plugin.SocketPath)
+		}
+		return plugin, nil
+	}
+
+	newPlugin := new(VolumePlugin)
+	newPlugin.Name = name
+	newPlugin.SocketPath = filepath.Clean(path)
+
+	// Need an HTTP client to force a Unix connection.
+	// And since we can reuse it, might as well cache it.
+	client := new(http.Client)
+	client.Timeout = defaultTimeout
+	client.Transport = &http.Transport{
+		DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+			return (&net.Dialer{}).DialContext(ctx, "unix", newPlugin.SocketPath)
+		},
+		DisableCompression: true,
+	}
+	newPlugin.Client = client
+
+	stat, err := os.Stat(newPlugin.SocketPath)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot access plugin %s socket %q", name, newPlugin.SocketPath)
+	}
+	if stat.Mode()&os.ModeType != os.ModeSocket {
+		return nil, errors.Wrapf(ErrNotPlugin, "volume plugin %s socket %q is not a unix domain socket", name, newPlugin.SocketPath)
+	}
+
+	if err := newPlugin.activate(); err != nil {
+		return nil, err
+	}
+
+	if plugins == nil {
+		plugins = make(map[string]*VolumePlugin)
+	}
+	plugins[newPlugin.Name] = newPlugin
+
+	return newPlugin, nil
+}
+
+// activate performs the plugin handshake and verifies that the plugin
+// implements the volume plugin API.
+func (p *VolumePlugin) activate() error {
+	resp, err := p.sendRequest(nil, false, activatePath)
+	if err != nil {
+		return errors.Wrapf(err, "error activating plugin %s", p.Name)
+	}
+	defer resp.Body.Close()
+
+	// Response code MUST be 200. Anything else, we have to assume it's not
+	// a valid plugin.
+	if resp.StatusCode != http.StatusOK {
+		return errors.Wrapf(ErrNotPlugin, "got status code %d from activation endpoint for plugin %s", resp.StatusCode, p.Name)
+	}
+
+	respStruct := new(activateResponse)
+	if err := p.decodeResponse(resp, respStruct); err != nil {
+		return err
+	}
+
+	for _, pluginType := range respStruct.Implements {
+		if pluginType == volumePluginType {
+			return nil
+		}
+	}
+	return errors.Wrapf(ErrNotVolumePlugin, "plugin %s does not implement volume plugin, instead provides %s", p.Name, strings.Join(respStruct.Implements, ", "))
+}
+
+// verifyReachable checks that the plugin socket still exists. If it does not,
+// the plugin is removed from the cache.
+func (p *VolumePlugin) verifyReachable() error {
+	if _, err := os.Stat(p.SocketPath); err != nil {
+		if os.IsNotExist(err) {
+			pluginsLock.Lock()
+			defer pluginsLock.Unlock()
+			delete(plugins, p.Name)
+			return errors.Wrapf(ErrPluginRemoved, "plugin %s", p.Name)
+		}
+
+		return errors.Wrapf(err, "error accessing plugin %s", p.Name)
+	}
+	return nil
+}
+
+// sendRequest sends a request to the given endpoint of the plugin. If hasBody
+// is set, toJSON is marshalled and used as the request body.
+func (p *VolumePlugin) sendRequest(toJSON interface{}, hasBody bool, endpoint string) (*http.Response, error) {
+	var reqBytes []byte
+	if hasBody {
+		b, err := json.Marshal(toJSON)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error marshalling request JSON for volume plugin %s endpoint %s", p.Name, endpoint)
+		}
+		reqBytes = b
+	}
+
+	// The host is irrelevant, the connection always goes to the socket.
+	req, err := http.NewRequest(http.MethodPost, "http://plugin"+endpoint, bytes.NewReader(reqBytes))
+	if err != nil {
+		return nil, errors.Wrapf(err, "error making request to volume plugin %s endpoint %s", p.Name, endpoint)
+	}
+	req.Header.Set("Accept", contentType)
+	if hasBody {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	logrus.Debugf("Sending request to volume plugin %s endpoint %s", p.Name, endpoint)
+	resp, err := p.Client.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error sending request to volume plugin %s endpoint %s", p.Name, endpoint)
+	}
+	return resp, nil
+}
+
+// decodeResponse reads the response body into the given struct.
+func (p *VolumePlugin) decodeResponse(resp *http.Response, into interface{}) error {
+	respBytes, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return errors.Wrapf(err, "error reading response body from volume plugin %s", p.Name)
+	}
+	if err := json.Unmarshal(respBytes, into); err != nil {
+		return errors.Wrapf(err, "error unmarshalling volume plugin %s response", p.Name)
+	}
+	return nil
+}
+
+// makeErrorResponse turns an error response from a volume plugin into a
+// well-formatted Go error.
+func (p *VolumePlugin) makeErrorResponse(err, endpoint, volName string) error {
+	if err == "" {
+		err = "empty error from plugin"
+	}
+	if volName != "" {
+		return errors.Wrapf(errors.New(err), "error on %s on volume %s in volume plugin %s", endpoint, volName, p.Name)
+	}
+	return errors.Wrapf(errors.New(err), "error on %s in volume plugin %s", endpoint, p.Name)
+}
+
+// do sends a request to the plugin and decodes the response into respStruct,
+// which must embed errorResponse or be nil. Error responses from the plugin
+// are turned into errors.
+func (p *VolumePlugin) do(endpoint, volName string, req interface{}, respStruct interface{}) error {
+	if err := p.verifyReachable(); err != nil {
+		return err
+	}
+
+	resp, err := p.sendRequest(req, req != nil, endpoint)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	// The reference implementation uses HTTP 500 for errors, but not all
+	// plugins may follow it. Interpret anything other than 200 as an error.
+	if resp.StatusCode != http.StatusOK {
+		errStruct := new(errorResponse)
+		if err := p.decodeResponse(resp, errStruct); err != nil {
+			return p.makeErrorResponse(resp.Status, endpoint, volName)
+		}
+		return p.makeErrorResponse(errStruct.Err, endpoint, volName)
+	}
+
+	if respStruct == nil {
+		respStruct = new(errorResponse)
+	}
+	if err := p.decodeResponse(resp, respStruct); err != nil {
+		return err
+	}
+	if errResp, ok := respStruct.(interface{ pluginError() string }); ok {
+		if msg := errResp.pluginError(); msg != "" {
+			return p.makeErrorResponse(msg, endpoint, volName)
+		}
+	}
+	return nil
+}
+
+func (e *errorResponse) pluginError() string {
+	return e.Err
+}
+
+// CreateVolume creates a volume in the plugin.
+func (p *VolumePlugin) CreateVolume(req *CreateRequest) error {
+	if req == nil {
+		return errors.Wrapf(define.ErrInvalidArg, "must provide non-nil request to CreateVolume")
+	}
+
+	logrus.Infof("Creating volume %s using plugin %s", req.Name, p.Name)
+	return p.do(volumeCreatePath, req.Name, req, nil)
+}
+
+// ListVolumes lists volumes available in the plugin.
+func (p *VolumePlugin) ListVolumes() ([]*Volume, error) {
+	logrus.Infof("Listing volumes using plugin %s", p.Name)
+	respStruct := new(listResponse)
+	if err := p.do(volumeListPath, "", nil, respStruct); err != nil {
+		return nil, err
+	}
+	return respStruct.Volumes, nil
+}
+
+// GetVolumeInfo gets a single volume from the plugin.
+func (p *VolumePlugin) GetVolumeInfo(req *GetRequest) (*Volume, error) {
+	if req == nil {
+		return nil, errors.Wrapf(define.ErrInvalidArg, "must provide non-nil request to GetVolumeInfo")
+	}
+
+	logrus.Infof("Getting volume %s using plugin %s", req.Name, p.Name)
+	respStruct := new(getResponse)
+	if err := p.do(volumeGetPath, req.Name, req, respStruct); err != nil {
+		return nil, err
+	}
+	return respStruct.Volume, nil
+}
+
+// RemoveVolume removes a single volume from the plugin.
+func (p *VolumePlugin) RemoveVolume(req *RemoveRequest) error {
+	if req == nil {
+		return errors.Wrapf(define.ErrInvalidArg, "must provide non-nil request to RemoveVolume")
+	}
+
+	logrus.Infof("Removing volume %s using plugin %s", req.Name, p.Name)
+	return p.do(volumeRemovePath, req.Name, req, nil)
+}
+
+// GetVolumePath gets the path the given volume is mounted at.
+func (p *VolumePlugin) GetVolumePath(req *PathRequest) (string, error) {
+	if req == nil {
+		return "", errors.Wrapf(define.ErrInvalidArg, "must provide non-nil request to GetVolumePath")
+	}
+
+	logrus.Infof("Getting volume %s path using plugin %s", req.Name, p.Name)
+	respStruct := new(pathResponse)
+	if err := p.do(volumeHostPathPath, req.Name, req, respStruct); err != nil {
+		return "", err
+	}
+	return respStruct.Mountpoint, nil
+}
+
+// MountVolume mounts the given volume. The ID argument is the ID of the
+// mounting container, used for internal record-keeping by the plugin. Returns
+// the path the volume has been mounted at.
+func (p *VolumePlugin) MountVolume(req *MountRequest) (string, error) {
+	if req == nil {
+		return "", errors.Wrapf(define.ErrInvalidArg, "must provide non-nil request to MountVolume")
+	}
+
+	logrus.Infof("Mounting volume %s using plugin %s for container %s", req.Name, p.Name, req.ID)
+	respStruct := new(pathResponse)
+	if err := p.do(volumeMountPath, req.Name, req, respStruct); err != nil {
+		return "", err
+	}
+	return respStruct.Mountpoint, nil
+}
+
+// UnmountVolume unmounts the given volume. The ID argument is the ID of the
+// container that is unmounting, used for internal record-keeping by the
+// plugin.
+func (p *VolumePlugin) UnmountVolume(req *UnmountRequest) error {
+	if req == nil {
+		return errors.Wrapf(define.ErrInvalidArg, "must provide non-nil request to UnmountVolume")
+	}
+
+	logrus.Infof("Unmounting volume %s using plugin %s for container %s", req.Name, p.Name, req.ID)
+	return p.do(volumeUnmountPath, req.Name, req, nil)
+}
+
+// Capabilities returns the capabilities of the plugin.
+func (p *VolumePlugin) Capabilities() (*Capability, error) {
+	if err := p.verifyReachable(); err != nil {
+		return nil, err
+	}
+
+	resp, err := p.sendRequest(nil, false, volumeCapabilitiesPath)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, p.makeErrorResponse(resp.Status, volumeCapabilitiesPath, "")
+	}
+	respStruct := new(capabilitiesResponse)
+	if err := p.decodeResponse(resp, respStruct); err != nil {
+		return nil, err
+	}
+	return &respStruct.Capabilities, nil
+}
@@ -0,0 +1,190 @@
+package plugin
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakePlugin serves a minimal volume plugin on a unix socket.
+type fakePlugin struct {
+	implements []string
+	volumes    map[string]string
+	mounted    map[string]bool
+}
+
+func (f *fakePlugin) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := ioutil.ReadAll(r.Body)
+	req := struct {
+		Name string
+		ID   string
+	}{}
+	if len(body) > 0 {
+		_ = json.Unmarshal(body, &req)
+	}
+
+	var resp interface{}
+	switch r.URL.Path {
+	case activatePath:
+		resp = activateResponse{Implements: f.implements}
+	case volumeCreatePath:
+		f.volumes[req.Name] = "/plugin/" + req.Name
+		resp = errorResponse{}
+	case volumeRemovePath:
+		if _, ok := f.volumes[req.Name]; !ok {
+			w.WriteHeader(http.StatusInternalServerError)
+			resp = errorResponse{Err: "no such volume"}
+			break
+		}
+		delete(f.volumes, req.Name)
+		resp = errorResponse{}
+	case volumeGetPath:
+		path, ok := f.volumes[req.Name]
+		if !ok {
+			resp = getResponse{errorResponse: errorResponse{Err: "no such volume"}}
+			break
+		}
+		resp = getResponse{Volume: &Volume{Name: req.Name, Mountpoint: path}}
+	case volumeListPath:
+		list := listResponse{}
+		for name, path := range f.volumes {
+			list.Volumes = append(list.Volumes, &Volume{Name: name, Mountpoint: path})
+		}
+		resp = list
+	case volumeHostPathPath:
+		resp = pathResponse{Mountpoint: f.volumes[req.Name]}
+	case volumeMountPath:
+		f.mounted[req.Name] = true
+		resp = pathResponse{Mountpoint: f.volumes[req.Name]}
+	case volumeUnmountPath:
+		delete(f.mounted, req.Name)
+		resp = errorResponse{}
+	case volumeCapabilitiesPath:
+		resp = capabilitiesResponse{Capabilities: Capability{Scope: "local"}}
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	b, _ := json.Marshal(resp)
+	_, _ = w.Write(b)
+}
+
+func startPlugin(t *testing.T, implements ...string) (*fakePlugin, string, func()) {
+	dir, err := ioutil.TempDir("", "volumeplugin")
+	require.NoError(t, err)
+	socket := filepath.Join(dir, "plugin.sock")
+	listener, err := net.Listen("unix", socket)
+	require.NoError(t, err)
+
+	fake := &fakePlugin{
+		implements: implements,
+		volumes:    make(map[string]string),
+		mounted:    make(map[string]bool),
+	}
+	server := httptest.NewUnstartedServer(fake)
+	server.Listener = listener
+	server.Start()
+
+	return fake, socket, func() {
+		server.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+func TestGetVolumePlugin(t *testing.T) {
+	_, socket, cleanup := startPlugin(t, volumePluginType)
+	defer cleanup()
+
+	p, err := GetVolumePlugin("testplugin", socket)
+	require.NoError(t, err)
+	assert.Equal(t, "testplugin", p.Name)
+
+	// The plugin is cached.
+	cached, err := GetVolumePlugin("testplugin", socket)
+	require.NoError(t, err)
+	assert.True(t, p == cached)
+
+	// A different path for the same plugin name is rejected.
+	_, err = GetVolumePlugin("testplugin", socket+"2")
+	assert.Error(t, err)
+}
+
+func TestGetVolumePluginNotVolumePlugin(t *testing.T) {
+	_, socket, cleanup := startPlugin(t, "NetworkDriver")
+	defer cleanup()
+
+	_, err := GetVolumePlugin("netplugin", socket)
+	assert.Equal(t, ErrNotVolumePlugin, errors.Cause(err))
+}
+
+func TestGetVolumePluginNotSocket(t *testing.T) {
+	file, err := ioutil.TempFile("", "notasocket")
+	require.NoError(t, err)
+	file.Close()
+	defer os.Remove(file.Name())
+
+	_, err = GetVolumePlugin("notasocket", file.Name())
+	assert.Equal(t, ErrNotPlugin, errors.Cause(err))
+}
+
+func TestVolumePluginLifecycle(t *testing.T) {
+	fake, socket, cleanup := startPlugin(t, volumePluginType)
+	defer cleanup()
+
+	p, err := GetVolumePlugin("lifecycle", socket)
+	require.NoError(t, err)
+
+	require.NoError(t, p.CreateVolume(&CreateRequest{Name: "vol1", Options: map[string]string{"size": "1G"}}))
+
+	vol, err := p.GetVolumeInfo(&GetRequest{Name: "vol1"})
+	require.NoError(t, err)
+	assert.Equal(t, "vol1", vol.Name)
+
+	_, err = p.GetVolumeInfo(&GetRequest{Name: "missing"})
+	assert.Error(t, err)
+
+	vols, err := p.ListVolumes()
+	require.NoError(t, err)
+	assert.Len(t, vols, 1)
+
+	path, err := p.GetVolumePath(&PathRequest{Name: "vol1"})
+	require.NoError(t, err)
+	assert.Equal(t, "/plugin/vol1", path)
+
+	path, err = p.MountVolume(&MountRequest{Name: "vol1", ID: "ctr"})
+	require.NoError(t, err)
+	assert.Equal(t, "/plugin/vol1", path)
+	assert.True(t, fake.mounted["vol1"])
+
+	require.NoError(t, p.UnmountVolume(&UnmountRequest{Name: "vol1", ID: "ctr"}))
+	assert.False(t, fake.mounted["vol1"])
+
+	caps, err := p.Capabilities()
+	require.NoError(t, err)
+	assert.Equal(t, "local", caps.Scope)
+
+	require.NoError(t, p.RemoveVolume(&RemoveRequest{Name: "vol1"}))
+	err = p.RemoveVolume(&RemoveRequest{Name: "vol1"})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "no such volume")
+}
+
+func TestVolumePluginRemoved(t *testing.T) {
+	_, socket, cleanup := startPlugin(t, volumePluginType)
+
+	p, err := GetVolumePlugin("removed", socket)
+	require.NoError(t, err)
+	cleanup()
+
+	_, err = p.ListVolumes()
+	assert.Equal(t, ErrPluginRemoved, errors.Cause(err))
+}
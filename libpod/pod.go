@@ -7,6 +7,7 @@ import (
 	"github.com/containers/podman/v2/libpod/define"
 	"github.com/containers/podman/v2/libpod/lock"
 	"github.com/cri-o/ocicni/pkg/ocicni"
+	spec "github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/pkg/errors"
 )
 
@@ -48,6 +49,9 @@ type PodConfig struct {
 	// If true, all containers joined to the pod will use the pod cgroup as
 	// their cgroup parent, and cannot set a different cgroup parent
 	UsePodCgroup bool `json:"sharesCgroup,omitempty"`
+	// ResourceLimits are the resource limits set on the pod's CGroup.
+	// Only used if UsePodCgroup is true.
+	ResourceLimits *spec.LinuxResources `json:"resourceLimits,omitempty"`
 
 	// The following UsePod{kernelNamespace} indicate whether the containers
 	// in the pod will inherit the namespace from the first container in the pod.
@@ -111,6 +115,18 @@ type InfraContainerConfig struct {
 	NetworkOptions     map[string][]string  `json:"network_options,omitempty"`
 }
 
+// PodCheckpoint describes a pod in its checkpoint archive. The archive holds
+// the checkpoint archives of all containers of the pod next to it.
+type PodCheckpoint struct {
+	// Config is the configuration of the pod
+	Config *PodConfig `json:"config"`
+	// InfraContainerID is the ID of the infra container of the pod
+	InfraContainerID string `json:"infraContainerID,omitempty"`
+	// Containers are the IDs of all containers of the pod in the order
+	// they have to be restored in
+	Containers []string `json:"containers"`
+}
+
 // ID retrieves the pod's ID
 func (p *Pod) ID() string {
 	return p.config.ID
@@ -2,6 +2,9 @@ package libpod
 
 import (
 	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 
 	"github.com/containers/podman/v2/libpod/define"
 	"github.com/containers/podman/v2/libpod/events"
@@ -440,6 +443,183 @@ func (p *Pod) Kill(ctx context.Context, signal uint) (map[string]error, error) {
 	return nil, nil
 }
 
+// Checkpoint checkpoints all containers of a pod. Every container of the pod
+// must be running. The containers are paused first, so that all of them are
+// checkpointed at the same point in time, and are then checkpointed in
+// reverse order of their dependencies, the infra container last.
+// If options.TargetFile is set, one archive holding the configuration of the
+// pod and the checkpoints of all of its containers is written to it.
+// An error and a map[string]error are returned.
+// If the error is not nil and the map is nil, an error was encountered before
+// any containers were checkpointed.
+// If map is not nil, an error was encountered when checkpointing one or more
+// containers. The container ID is mapped to the error encountered. The error is
+// set to ErrPodPartialFail.
+// If both error and the map are nil, all containers were checkpointed without
+// error.
+func (p *Pod) Checkpoint(ctx context.Context, options ContainerCheckpointOptions) (map[string]error, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if !p.valid {
+		return nil, define.ErrPodRemoved
+	}
+
+	if options.Name != "" {
+		return nil, errors.Wrapf(define.ErrInvalidArg, "cannot set a name when checkpointing pod %s", p.ID())
+	}
+
+	allCtrs, err := p.runtime.state.PodContainers(p)
+	if err != nil {
+		return nil, err
+	}
+	if len(allCtrs) == 0 {
+		return nil, errors.Wrapf(define.ErrNoSuchCtr, "pod %s has no containers to checkpoint", p.ID())
+	}
+
+	// Build a dependency graph of containers in the pod
+	graph, err := BuildContainerGraph(allCtrs)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error generating dependency graph for pod %s", p.ID())
+	}
+	ctrs := graph.dependencyOrder()
+
+	exportDir := ""
+	if options.TargetFile != "" {
+		exportDir, err = ioutil.TempDir("", "pod-checkpoint")
+		if err != nil {
+			return nil, err
+		}
+		defer func() {
+			if err := os.RemoveAll(exportDir); err != nil {
+				logrus.Errorf("could not recursively remove %s: %q", exportDir, err)
+			}
+		}()
+	}
+
+	paused, err := pauseForCheckpoint(ctrs)
+	if err != nil {
+		return nil, err
+	}
+	defer unpauseAfterCheckpoint(paused)
+
+	ctrErrors := make(map[string]error)
+
+	for i := len(ctrs) - 1; i >= 0; i-- {
+		ctr := ctrs[i]
+		ctrOptions := options
+		ctrOptions.pod = true
+		if exportDir != "" {
+			ctrOptions.TargetFile = filepath.Join(exportDir, ctr.ID()+".tar.gz")
+		}
+		if err := ctr.checkpointInPod(ctx, ctrOptions); err != nil {
+			ctrErrors[ctr.ID()] = err
+		}
+	}
+
+	if len(ctrErrors) > 0 {
+		return ctrErrors, errors.Wrapf(define.ErrPodPartialFail, "error checkpointing some containers")
+	}
+
+	if exportDir != "" {
+		if err := p.exportCheckpoint(exportDir, ctrs, options.TargetFile); err != nil {
+			return nil, err
+		}
+	}
+
+	defer p.newPodEvent(events.Checkpoint)
+	return nil, nil
+}
+
+// Restore restores all containers of a pod from their checkpoints. The
+// containers are restored in order of their dependencies, the infra container
+// first, so that the other containers join the namespaces of the restored
+// infra container.
+// If options.TargetFile is set, the checkpoints of the containers are read
+// from the checkpoint archive of the pod. The pod and its containers must
+// have been re-created from the archive before.
+// An error and a map[string]error are returned.
+// If the error is not nil and the map is nil, an error was encountered before
+// any containers were restored.
+// If map is not nil, an error was encountered when restoring one or more
+// containers. The container ID is mapped to the error encountered. The error is
+// set to ErrPodPartialFail.
+// If both error and the map are nil, all containers were restored without
+// error.
+func (p *Pod) Restore(ctx context.Context, options ContainerCheckpointOptions) (map[string]error, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if !p.valid {
+		return nil, define.ErrPodRemoved
+	}
+
+	if options.Name != "" {
+		return nil, errors.Wrapf(define.ErrInvalidArg, "cannot set a name when restoring pod %s", p.ID())
+	}
+
+	allCtrs, err := p.runtime.state.PodContainers(p)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build a dependency graph of containers in the pod
+	graph, err := BuildContainerGraph(allCtrs)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error generating dependency graph for pod %s", p.ID())
+	}
+
+	importDir := ""
+	if options.TargetFile != "" {
+		importDir, err = ioutil.TempDir("", "pod-checkpoint")
+		if err != nil {
+			return nil, err
+		}
+		defer func() {
+			if err := os.RemoveAll(importDir); err != nil {
+				logrus.Errorf("could not recursively remove %s: %q", importDir, err)
+			}
+		}()
+		if err := importPodCheckpoint(options.TargetFile, importDir); err != nil {
+			return nil, err
+		}
+	}
+
+	ctrErrors := make(map[string]error)
+
+	for _, ctr := range graph.dependencyOrder() {
+		// Do not try to restore a container if one of its dependencies
+		// could not be restored
+		depFailed := false
+		for _, dep := range ctr.Dependencies() {
+			if _, ok := ctrErrors[dep]; ok {
+				depFailed = true
+				break
+			}
+		}
+		if depFailed {
+			ctrErrors[ctr.ID()] = errors.Wrapf(define.ErrCtrStateInvalid, "a dependency of container %s failed to restore", ctr.ID())
+			continue
+		}
+
+		ctrOptions := options
+		ctrOptions.pod = true
+		if importDir != "" {
+			ctrOptions.TargetFile = filepath.Join(importDir, ctr.ID()+".tar.gz")
+		}
+		if err := ctr.Restore(ctx, ctrOptions); err != nil {
+			ctrErrors[ctr.ID()] = err
+		}
+	}
+
+	if len(ctrErrors) > 0 {
+		return ctrErrors, errors.Wrapf(define.ErrPodPartialFail, "error restoring some containers")
+	}
+
+	defer p.newPodEvent(events.Restore)
+	return nil, nil
+}
+
 // Status gets the status of all containers in the pod.
 // Returns a map of Container ID to Container Status.
 func (p *Pod) Status() (map[string]define.ContainerStatus, error) {
@@ -582,6 +762,23 @@ func (p *Pod) Inspect() (*define.InspectPodData, error) {
 		NumContainers:    uint(len(containers)),
 		Containers:       ctrs,
 	}
+	if res := p.config.ResourceLimits; res != nil {
+		if res.CPU != nil {
+			if res.CPU.Period != nil {
+				inspectData.CPUPeriod = *res.CPU.Period
+			}
+			if res.CPU.Quota != nil {
+				inspectData.CPUQuota = *res.CPU.Quota
+			}
+			inspectData.CPUSetCPUs = res.CPU.Cpus
+		}
+		if res.Memory != nil && res.Memory.Limit != nil {
+			inspectData.MemoryLimit = *res.Memory.Limit
+		}
+		if res.Pids != nil {
+			inspectData.PidsLimit = res.Pids.Limit
+		}
+	}
 
 	return &inspectData, nil
 }
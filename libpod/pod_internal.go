@@ -1,12 +1,17 @@
 package libpod
 
 import (
+	"context"
 	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
 	"path/filepath"
 	"time"
 
 	"github.com/containers/common/pkg/config"
 	"github.com/containers/podman/v2/libpod/define"
+	"github.com/containers/storage/pkg/archive"
 	"github.com/containers/storage/pkg/stringid"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -68,13 +73,18 @@ func (p *Pod) refresh() error {
 	if p.config.UsePodCgroup {
 		switch p.runtime.config.Engine.CgroupManager {
 		case config.SystemdCgroupsManager:
-			cgroupPath, err := systemdSliceFromPath(p.config.CgroupParent, fmt.Sprintf("libpod_pod_%s", p.ID()))
+			cgroupPath, err := systemdSliceFromPath(p.config.CgroupParent, fmt.Sprintf("libpod_pod_%s", p.ID()), p.config.ResourceLimits)
 			if err != nil {
 				logrus.Errorf("Error creating CGroup for pod %s: %v", p.ID(), err)
 			}
 			p.state.CgroupPath = cgroupPath
 		case config.CgroupfsCgroupsManager:
 			p.state.CgroupPath = filepath.Join(p.config.CgroupParent, p.ID())
+			if p.config.ResourceLimits != nil {
+				if err := makeCgroupfsCgroup(p.state.CgroupPath, p.config.ResourceLimits); err != nil {
+					logrus.Errorf("Error creating CGroup for pod %s: %v", p.ID(), err)
+				}
+			}
 
 			logrus.Debugf("setting pod cgroup to %s", p.state.CgroupPath)
 		default:
@@ -85,3 +95,121 @@ func (p *Pod) refresh() error {
 	// Save changes
 	return p.save()
 }
+
+// pauseForCheckpoint pauses all running containers of a pod to checkpoint
+// them at the same point in time. Every container must be running or paused
+// already. The containers paused here are returned.
+func pauseForCheckpoint(ctrs []*Container) ([]*Container, error) {
+	paused := make([]*Container, 0, len(ctrs))
+	for _, ctr := range ctrs {
+		ctr.lock.Lock()
+		err := ctr.syncContainer()
+		if err == nil {
+			switch ctr.state.State {
+			case define.ContainerStateRunning:
+				if err = ctr.pause(); err == nil {
+					paused = append(paused, ctr)
+				}
+			case define.ContainerStatePaused:
+			default:
+				err = errors.Wrapf(define.ErrCtrStateInvalid, "container %s is %s, all containers of a pod must be running to checkpoint it", ctr.ID(), ctr.state.State)
+			}
+		}
+		ctr.lock.Unlock()
+		if err != nil {
+			unpauseAfterCheckpoint(paused)
+			return nil, err
+		}
+	}
+	return paused, nil
+}
+
+// unpauseAfterCheckpoint unpauses the containers paused for a checkpoint
+// that have been left running.
+func unpauseAfterCheckpoint(ctrs []*Container) {
+	for _, ctr := range ctrs {
+		ctr.lock.Lock()
+		if err := ctr.syncContainer(); err != nil {
+			logrus.Errorf("Error syncing container %s after checkpoint: %v", ctr.ID(), err)
+		} else if ctr.state.State == define.ContainerStatePaused {
+			if err := ctr.unpause(); err != nil {
+				logrus.Errorf("Error unpausing container %s after checkpoint: %v", ctr.ID(), err)
+			}
+		}
+		ctr.lock.Unlock()
+	}
+}
+
+// checkpointInPod checkpoints a container as part of checkpointing its pod.
+func (c *Container) checkpointInPod(ctx context.Context, options ContainerCheckpointOptions) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if err := c.syncContainer(); err != nil {
+		return err
+	}
+	if options.TargetFile != "" {
+		if err := c.prepareCheckpointExport(); err != nil {
+			return err
+		}
+	}
+	return c.checkpoint(ctx, options)
+}
+
+// exportCheckpoint writes the checkpoint archive of the pod to dest. The
+// checkpoint archives of its containers have been exported to dir.
+func (p *Pod) exportCheckpoint(dir string, ctrs []*Container, dest string) error {
+	logrus.Debugf("Exporting checkpoint of pod %q to %q", p.ID(), dest)
+
+	podCheckpoint := PodCheckpoint{
+		Config:           p.config,
+		InfraContainerID: p.state.InfraContainerID,
+		Containers:       make([]string, 0, len(ctrs)),
+	}
+	for _, ctr := range ctrs {
+		podCheckpoint.Containers = append(podCheckpoint.Containers, ctr.ID())
+	}
+	formatJSON, err := json.MarshalIndent(podCheckpoint, "", "     ")
+	if err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "pod.dump"), formatJSON, 0600); err != nil {
+		return errors.Wrap(err, "error creating pod checkpoint description")
+	}
+
+	// The archives of the containers are compressed already
+	input, err := archive.TarWithOptions(dir, &archive.TarOptions{
+		Compression: archive.Uncompressed,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "error reading checkpoint directory of pod %q", p.ID())
+	}
+	defer input.Close()
+
+	outFile, err := os.Create(dest)
+	if err != nil {
+		return errors.Wrapf(err, "error creating checkpoint export file %q", dest)
+	}
+	defer outFile.Close()
+
+	if err := os.Chmod(dest, 0600); err != nil {
+		return err
+	}
+
+	_, err = io.Copy(outFile, input)
+	return err
+}
+
+// importPodCheckpoint unpacks the checkpoint archive of a pod into dir.
+func importPodCheckpoint(input, dir string) error {
+	archiveFile, err := os.Open(input)
+	if err != nil {
+		return errors.Wrap(err, "failed to open pod checkpoint archive for import")
+	}
+	defer archiveFile.Close()
+
+	if err := archive.Untar(archiveFile, dir, nil); err != nil {
+		return errors.Wrapf(err, "Unpacking of pod checkpoint archive %s failed", input)
+	}
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 	"sync"
 	"syscall"
 
+	"github.com/BurntSushi/toml"
 	"github.com/containers/common/pkg/config"
 	"github.com/containers/image/v5/pkg/sysregistriesv2"
 	is "github.com/containers/image/v5/storage"
@@ -17,10 +18,12 @@ import (
 	"github.com/containers/podman/v2/libpod/events"
 	"github.com/containers/podman/v2/libpod/image"
 	"github.com/containers/podman/v2/libpod/lock"
+	"github.com/containers/podman/v2/libpod/plugin"
 	"github.com/containers/podman/v2/libpod/shutdown"
 	"github.com/containers/podman/v2/pkg/cgroups"
 	"github.com/containers/podman/v2/pkg/registries"
 	"github.com/containers/podman/v2/pkg/rootless"
+	"github.com/containers/podman/v2/pkg/secrets"
 	"github.com/containers/podman/v2/pkg/util"
 	"github.com/containers/storage"
 	"github.com/cri-o/ocicni/pkg/ocicni"
@@ -84,6 +87,15 @@ type Runtime struct {
 
 	// noStore indicates whether we need to interact with a store or not
 	noStore bool
+
+	// secretsManager manages secrets. It is created on first use, guarded
+	// by secretsManagerLock.
+	secretsManager     *secrets.SecretsManager
+	secretsManagerLock sync.Mutex
+
+	// volumePlugins maps the names of the configured volume plugins to the
+	// paths of their sockets.
+	volumePlugins map[string]string
 }
 
 // SetXdgDirs ensures the XDG_RUNTIME_DIR env and XDG_CONFIG_HOME variables are set.
@@ -162,6 +174,12 @@ func newRuntimeFromConfig(ctx context.Context, conf *config.Config, options ...R
 
 	runtime.config = conf
 
+	volumePlugins, err := loadVolumePlugins()
+	if err != nil {
+		return nil, err
+	}
+	runtime.volumePlugins = volumePlugins
+
 	storeOpts, err := storage.DefaultStoreOptions(rootless.IsRootless(), rootless.GetRootlessUID())
 	if err != nil {
 		return nil, err
@@ -744,6 +762,86 @@ func (r *Runtime) GetStore() storage.Store {
 	return r.store
 }
 
+// SecretsManager returns the secrets manager of the runtime, creating it
+// on first use. Secrets are stored in the "secrets" directory of the
+// storage root.
+func (r *Runtime) SecretsManager() (*secrets.SecretsManager, error) {
+	r.secretsManagerLock.Lock()
+	defer r.secretsManagerLock.Unlock()
+
+	if r.secretsManager == nil {
+		manager, err := secrets.NewManager(r.GetSecretsStorageDir())
+		if err != nil {
+			return nil, err
+		}
+		r.secretsManager = manager
+	}
+	return r.secretsManager, nil
+}
+
+// GetSecretsStorageDir returns the directory holding the secrets of the
+// runtime.
+func (r *Runtime) GetSecretsStorageDir() string {
+	return filepath.Join(r.store.GraphRoot(), "secrets")
+}
+
+// volumePluginsConfig is the section of containers.conf configuring volume
+// plugins, as a table of plugin names to socket paths:
+//
+//   [engine.volume_plugins]
+//   myplugin = "/run/docker/plugins/myplugin.sock"
+type volumePluginsConfig struct {
+	Engine struct {
+		VolumePlugins map[string]string `toml:"volume_plugins"`
+	} `toml:"engine"`
+}
+
+// loadVolumePlugins reads the volume plugins from the containers.conf files,
+// in the same order and with the same precedence as the rest of the
+// configuration. containers/common does not parse this section, so read it
+// here.
+func loadVolumePlugins() (map[string]string, error) {
+	var paths []string
+	if path := os.Getenv("CONTAINERS_CONF"); path != "" {
+		paths = append(paths, path)
+	} else {
+		paths = append(paths, config.DefaultContainersConfig, config.OverrideContainersConfig)
+		if rootless.IsRootless() {
+			paths = append(paths, config.Path())
+		}
+	}
+
+	volumePlugins := make(map[string]string)
+	for _, path := range paths {
+		if _, err := os.Stat(path); err != nil {
+			continue
+		}
+		conf := new(volumePluginsConfig)
+		if _, err := toml.DecodeFile(path, conf); err != nil {
+			return nil, errors.Wrapf(err, "unable to decode configuration %v", path)
+		}
+		for name, socket := range conf.Engine.VolumePlugins {
+			volumePlugins[name] = socket
+		}
+	}
+	return volumePlugins, nil
+}
+
+// getVolumePlugin gets a specific volume plugin given its name.
+// There is no plugin for the local driver, nil is returned for it.
+func (r *Runtime) getVolumePlugin(name string) (*plugin.VolumePlugin, error) {
+	if name == define.VolumeDriverLocal || name == "" {
+		return nil, nil
+	}
+
+	pluginPath, ok := r.volumePlugins[name]
+	if !ok {
+		return nil, errors.Wrapf(define.ErrMissingPlugin, "no volume plugin with name %s available", name)
+	}
+
+	return plugin.GetVolumePlugin(name, pluginPath)
+}
+
 // GetName retrieves the name associated with a given full ID.
 // This works for both containers and pods, and does not distinguish between the
 // two.
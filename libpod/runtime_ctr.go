@@ -260,6 +260,15 @@ func (r *Runtime) setupContainer(ctx context.Context, ctr *Container) (_ *Contai
 		g := generate.Generator{Config: ctr.config.Spec}
 		g.RemoveMount("/dev/shm")
 		ctr.config.ShmDir = ""
+		// A container sharing the IPC namespace of another container,
+		// e.g. of the infra container of its pod, uses its /dev/shm.
+		if ctr.config.IPCNsCtr != "" {
+			ipcCtr, err := r.state.Container(ctr.config.IPCNsCtr)
+			if err != nil {
+				return nil, errors.Wrapf(err, "error retrieving IPC namespace container %s of container %s", ctr.config.IPCNsCtr, ctr.ID())
+			}
+			ctr.config.ShmDir = ipcCtr.ShmDir()
+		}
 		g.RemoveMount("/etc/resolv.conf")
 		g.RemoveMount("/etc/hostname")
 		g.RemoveMount("/etc/hosts")
@@ -376,6 +385,60 @@ func (r *Runtime) setupContainer(ctx context.Context, ctr *Container) (_ *Contai
 	return ctr, nil
 }
 
+// RenameContainer renames the given container.
+// The new name must not be in use by another container or pod. Infra
+// containers cannot be renamed.
+func (r *Runtime) RenameContainer(ctx context.Context, ctr *Container, newName string) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if !r.valid {
+		return define.ErrRuntimeStopped
+	}
+
+	if !define.NameRegex.MatchString(newName) {
+		return define.RegexError
+	}
+
+	if ctr.IsInfra() {
+		return errors.Wrapf(define.ErrInvalidArg, "cannot rename infra container %s", ctr.ID())
+	}
+
+	ctr.lock.Lock()
+	defer ctr.lock.Unlock()
+
+	if err := ctr.syncContainer(); err != nil {
+		return err
+	}
+
+	oldName := ctr.Name()
+	if oldName == newName {
+		return nil
+	}
+
+	newConfig := new(ContainerConfig)
+	if err := JSONDeepCopy(ctr.config, newConfig); err != nil {
+		return errors.Wrapf(err, "error copying configuration of container %s", ctr.ID())
+	}
+	newConfig.Name = newName
+
+	if err := r.state.SafeRewriteContainerConfig(ctr, oldName, newName, newConfig); err != nil {
+		return errors.Wrapf(err, "error renaming container %s", ctr.ID())
+	}
+
+	// Keep the name of the container in c/storage in sync, so the old
+	// name can be reused.
+	if ctr.config.Rootfs == "" {
+		if err := r.store.SetNames(ctr.ID(), []string{newName}); err != nil {
+			logrus.Errorf("Error renaming container %s in storage: %v", ctr.ID(), err)
+		}
+	}
+
+	ctr.config = newConfig
+	ctr.newContainerEvent(events.Rename)
+	return nil
+}
+
 // RemoveContainer removes the given container
 // If force is specified, the container will be stopped first
 // If removeVolume is specified, named volumes used by the container will
@@ -72,20 +72,127 @@ func (r *Runtime) NewPod(ctx context.Context, options ...PodCreateOption) (_ *Po
 
 	pod.valid = true
 
+	if err := r.setupPodCgroup(pod); err != nil {
+		return nil, err
+	}
+	if !pod.HasInfraContainer() && pod.SharesNamespaces() {
+		return nil, errors.Errorf("Pods must have an infra container to share namespaces")
+	}
+	if pod.HasInfraContainer() && !pod.SharesNamespaces() {
+		logrus.Warnf("Pod has an infra container, but shares no namespaces")
+	}
+
+	if err := r.state.AddPod(pod); err != nil {
+		return nil, errors.Wrapf(err, "error adding pod to state")
+	}
+	defer func() {
+		if deferredErr != nil {
+			if err := r.removePod(ctx, pod, true, true); err != nil {
+				logrus.Errorf("Error removing pod after pause container creation failure: %v", err)
+			}
+		}
+	}()
+
+	if pod.HasInfraContainer() {
+		ctr, err := r.createInfraContainer(ctx, pod)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error adding Infra Container")
+		}
+		pod.state.InfraContainerID = ctr.ID()
+		if err := pod.save(); err != nil {
+			return nil, err
+		}
+	}
+	pod.newPodEvent(events.Create)
+	return pod, nil
+}
+
+// RestorePod re-creates a pod from the configuration stored in its checkpoint
+// archive. The containers of the pod, including its infra container, are
+// re-created separately afterwards.
+func (r *Runtime) RestorePod(ctx context.Context, config *PodConfig, infraContainerID string) (_ *Pod, deferredErr error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if !r.valid {
+		return nil, define.ErrRuntimeStopped
+	}
+
+	if config == nil {
+		return nil, errors.Wrapf(define.ErrInvalidArg, "must provide a valid pod configuration to restore a pod")
+	}
+
+	pod := newPod(r)
+	pod.config = config
+	pod.state.InfraContainerID = infraContainerID
+
+	// Allocate a lock for the pod
+	lock, err := r.lockManager.AllocateLock()
+	if err != nil {
+		return nil, errors.Wrapf(err, "error allocating lock for restored pod")
+	}
+	pod.lock = lock
+	pod.config.LockID = pod.lock.ID()
+
+	defer func() {
+		if deferredErr != nil {
+			if err := pod.lock.Free(); err != nil {
+				logrus.Errorf("Error freeing pod lock after failed restore: %v", err)
+			}
+		}
+	}()
+
+	pod.valid = true
+
+	if err := r.setupPodCgroup(pod); err != nil {
+		return nil, err
+	}
+
+	if err := r.state.AddPod(pod); err != nil {
+		return nil, errors.Wrapf(err, "error adding pod to state")
+	}
+	pod.newPodEvent(events.Create)
+	return pod, nil
+}
+
+// setupPodCgroup checks the cgroup parent of the pod, sets it if it was not
+// set and sets the path of the cgroup of the pod if it uses one.
+func (r *Runtime) setupPodCgroup(pod *Pod) error {
+	if pod.config.ResourceLimits != nil {
+		if !pod.config.UsePodCgroup {
+			return errors.Wrapf(define.ErrInvalidArg, "resource limits can only be set on pods that create their own cgroup")
+		}
+		if rootless.IsRootless() {
+			cgroup2, err := cgroups.IsCgroup2UnifiedMode()
+			if err != nil {
+				return err
+			}
+			if !cgroup2 || r.config.Engine.CgroupManager != config.SystemdCgroupsManager {
+				return errors.Wrapf(define.ErrInvalidArg, "resource limits on pods require cgroup v2 and the systemd cgroup manager when running rootless")
+			}
+		}
+	}
+
 	// Check CGroup parent sanity, and set it if it was not set
 	switch r.config.Engine.CgroupManager {
 	case config.CgroupfsCgroupsManager:
 		if pod.config.CgroupParent == "" {
 			pod.config.CgroupParent = CgroupfsDefaultCgroupParent
 		} else if strings.HasSuffix(path.Base(pod.config.CgroupParent), ".slice") {
-			return nil, errors.Wrapf(define.ErrInvalidArg, "systemd slice received as cgroup parent when using cgroupfs")
+			return errors.Wrapf(define.ErrInvalidArg, "systemd slice received as cgroup parent when using cgroupfs")
 		}
 		// If we are set to use pod cgroups, set the cgroup parent that
 		// all containers in the pod will share
 		// No need to create it with cgroupfs - the first container to
-		// launch should do it for us
+		// launch should do it for us - unless we have to set resource
+		// limits on it
 		if pod.config.UsePodCgroup {
 			pod.state.CgroupPath = filepath.Join(pod.config.CgroupParent, pod.ID())
+			if pod.config.ResourceLimits != nil {
+				if err := makeCgroupfsCgroup(pod.state.CgroupPath, pod.config.ResourceLimits); err != nil {
+					return errors.Wrapf(err, "unable to create pod cgroup for pod %s", pod.ID())
+				}
+			}
 		}
 	case config.SystemdCgroupsManager:
 		if pod.config.CgroupParent == "" {
@@ -95,54 +202,25 @@ func (r *Runtime) NewPod(ctx context.Context, options ...PodCreateOption) (_ *Po
 				pod.config.CgroupParent = SystemdDefaultCgroupParent
 			}
 		} else if len(pod.config.CgroupParent) < 6 || !strings.HasSuffix(path.Base(pod.config.CgroupParent), ".slice") {
-			return nil, errors.Wrapf(define.ErrInvalidArg, "did not receive systemd slice as cgroup parent when using systemd to manage cgroups")
+			return errors.Wrapf(define.ErrInvalidArg, "did not receive systemd slice as cgroup parent when using systemd to manage cgroups")
 		}
 		// If we are set to use pod cgroups, set the cgroup parent that
 		// all containers in the pod will share
 		if pod.config.UsePodCgroup {
-			cgroupPath, err := systemdSliceFromPath(pod.config.CgroupParent, fmt.Sprintf("libpod_pod_%s", pod.ID()))
+			cgroupPath, err := systemdSliceFromPath(pod.config.CgroupParent, fmt.Sprintf("libpod_pod_%s", pod.ID()), pod.config.ResourceLimits)
 			if err != nil {
-				return nil, errors.Wrapf(err, "unable to create pod cgroup for pod %s", pod.ID())
+				return errors.Wrapf(err, "unable to create pod cgroup for pod %s", pod.ID())
 			}
 			pod.state.CgroupPath = cgroupPath
 		}
 	default:
-		return nil, errors.Wrapf(define.ErrInvalidArg, "unsupported CGroup manager: %s - cannot validate cgroup parent", r.config.Engine.CgroupManager)
+		return errors.Wrapf(define.ErrInvalidArg, "unsupported CGroup manager: %s - cannot validate cgroup parent", r.config.Engine.CgroupManager)
 	}
 
 	if pod.config.UsePodCgroup {
 		logrus.Debugf("Got pod cgroup as %s", pod.state.CgroupPath)
 	}
-	if !pod.HasInfraContainer() && pod.SharesNamespaces() {
-		return nil, errors.Errorf("Pods must have an infra container to share namespaces")
-	}
-	if pod.HasInfraContainer() && !pod.SharesNamespaces() {
-		logrus.Warnf("Pod has an infra container, but shares no namespaces")
-	}
-
-	if err := r.state.AddPod(pod); err != nil {
-		return nil, errors.Wrapf(err, "error adding pod to state")
-	}
-	defer func() {
-		if deferredErr != nil {
-			if err := r.removePod(ctx, pod, true, true); err != nil {
-				logrus.Errorf("Error removing pod after pause container creation failure: %v", err)
-			}
-		}
-	}()
-
-	if pod.HasInfraContainer() {
-		ctr, err := r.createInfraContainer(ctx, pod)
-		if err != nil {
-			return nil, errors.Wrapf(err, "error adding Infra Container")
-		}
-		pod.state.InfraContainerID = ctr.ID()
-		if err := pod.save(); err != nil {
-			return nil, err
-		}
-	}
-	pod.newPodEvent(events.Create)
-	return pod, nil
+	return nil
 }
 
 func (r *Runtime) removePod(ctx context.Context, p *Pod, removeCtrs, force bool) error {
@@ -13,6 +13,12 @@ func (r *Runtime) NewPod(ctx context.Context, options ...PodCreateOption) (*Pod,
 	return nil, define.ErrOSNotSupported
 }
 
+// RestorePod re-creates a pod from the configuration stored in its checkpoint
+// archive
+func (r *Runtime) RestorePod(ctx context.Context, config *PodConfig, infraContainerID string) (*Pod, error) {
+	return nil, define.ErrOSNotSupported
+}
+
 func (r *Runtime) removePod(ctx context.Context, p *Pod, removeCtrs, force bool) error {
 	return define.ErrOSNotSupported
 }
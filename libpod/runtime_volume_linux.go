@@ -11,6 +11,7 @@ import (
 
 	"github.com/containers/podman/v2/libpod/define"
 	"github.com/containers/podman/v2/libpod/events"
+	"github.com/containers/podman/v2/libpod/plugin"
 	"github.com/containers/storage/pkg/stringid"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -53,6 +54,13 @@ func (r *Runtime) newVolume(ctx context.Context, options ...VolumeCreateOption)
 		return nil, errors.Wrapf(define.ErrVolumeExists, "volume with name %s already exists", volume.config.Name)
 	}
 
+	// The plugin is nil for the local driver.
+	plugin, err := r.getVolumePlugin(volume.config.Driver)
+	if err != nil {
+		return nil, errors.Wrapf(err, "volume %s uses volume plugin %s but it could not be retrieved", volume.config.Name, volume.config.Driver)
+	}
+	volume.plugin = plugin
+
 	if volume.config.Driver == define.VolumeDriverLocal {
 		logrus.Debugf("Validating options for local driver")
 		// Validate options
@@ -66,25 +74,35 @@ func (r *Runtime) newVolume(ctx context.Context, options ...VolumeCreateOption)
 		}
 	}
 
-	// Create the mountpoint of this volume
-	volPathRoot := filepath.Join(r.config.Engine.VolumePath, volume.config.Name)
-	if err := os.MkdirAll(volPathRoot, 0700); err != nil {
-		return nil, errors.Wrapf(err, "error creating volume directory %q", volPathRoot)
-	}
-	if err := os.Chown(volPathRoot, volume.config.UID, volume.config.GID); err != nil {
-		return nil, errors.Wrapf(err, "error chowning volume directory %q to %d:%d", volPathRoot, volume.config.UID, volume.config.GID)
-	}
-	fullVolPath := filepath.Join(volPathRoot, "_data")
-	if err := os.MkdirAll(fullVolPath, 0755); err != nil {
-		return nil, errors.Wrapf(err, "error creating volume directory %q", fullVolPath)
-	}
-	if err := os.Chown(fullVolPath, volume.config.UID, volume.config.GID); err != nil {
-		return nil, errors.Wrapf(err, "error chowning volume directory %q to %d:%d", fullVolPath, volume.config.UID, volume.config.GID)
-	}
-	if err := LabelVolumePath(fullVolPath); err != nil {
-		return nil, err
+	// Now we get conditional: we either need to make the volume in the
+	// volume plugin, or on disk if not using a plugin.
+	if volume.plugin != nil {
+		// The path of the volume is managed by the plugin, so we do
+		// not chown or relabel it.
+		if err := makeVolumeInPluginIfNotExist(volume.config.Name, volume.config.Options, volume.plugin); err != nil {
+			return nil, err
+		}
+	} else {
+		// Create the mountpoint of this volume
+		volPathRoot := filepath.Join(r.config.Engine.VolumePath, volume.config.Name)
+		if err := os.MkdirAll(volPathRoot, 0700); err != nil {
+			return nil, errors.Wrapf(err, "error creating volume directory %q", volPathRoot)
+		}
+		if err := os.Chown(volPathRoot, volume.config.UID, volume.config.GID); err != nil {
+			return nil, errors.Wrapf(err, "error chowning volume directory %q to %d:%d", volPathRoot, volume.config.UID, volume.config.GID)
+		}
+		fullVolPath := filepath.Join(volPathRoot, "_data")
+		if err := os.MkdirAll(fullVolPath, 0755); err != nil {
+			return nil, errors.Wrapf(err, "error creating volume directory %q", fullVolPath)
+		}
+		if err := os.Chown(fullVolPath, volume.config.UID, volume.config.GID); err != nil {
+			return nil, errors.Wrapf(err, "error chowning volume directory %q to %d:%d", fullVolPath, volume.config.UID, volume.config.GID)
+		}
+		if err := LabelVolumePath(fullVolPath); err != nil {
+			return nil, err
+		}
+		volume.config.MountPoint = fullVolPath
 	}
-	volume.config.MountPoint = fullVolPath
 
 	lock, err := r.lockManager.AllocateLock()
 	if err != nil {
@@ -111,6 +129,37 @@ func (r *Runtime) newVolume(ctx context.Context, options ...VolumeCreateOption)
 	return volume, nil
 }
 
+// makeVolumeInPluginIfNotExist makes a volume in the given volume plugin if it
+// does not already exist.
+func makeVolumeInPluginIfNotExist(name string, options map[string]string, volPlugin *plugin.VolumePlugin) error {
+	// Ask the volume plugin if the volume exists first. If it does, use
+	// the existing volume in the plugin. Options may not match exactly,
+	// but there is not much we can do about that.
+	needsCreate := true
+	getReq := new(plugin.GetRequest)
+	getReq.Name = name
+	if resp, err := volPlugin.GetVolumeInfo(getReq); err == nil {
+		// A successful response without a volume is treated as no
+		// matching volume.
+		if resp != nil {
+			needsCreate = false
+		}
+	} else {
+		logrus.Infof("Volume %q does not exist in volume plugin %q: %v", name, volPlugin.Name, err)
+	}
+
+	if needsCreate {
+		createReq := new(plugin.CreateRequest)
+		createReq.Name = name
+		createReq.Options = options
+		if err := volPlugin.CreateVolume(createReq); err != nil {
+			return errors.Wrapf(err, "error creating volume %q in plugin %s", name, volPlugin.Name)
+		}
+	}
+
+	return nil
+}
+
 // removeVolume removes the specified volume from state as well tears down its mountpoint and storage
 func (r *Runtime) removeVolume(ctx context.Context, v *Volume, force bool) error {
 	if !v.valid {
@@ -175,6 +224,26 @@ func (r *Runtime) removeVolume(ctx context.Context, v *Volume, force bool) error
 		}
 	}
 
+	// If the volume is managed by a volume plugin, remove it there first.
+	if v.UsesVolumeDriver() {
+		if v.plugin == nil {
+			err := errors.Wrapf(define.ErrMissingPlugin, "cannot remove volume %s from plugin %s, it cannot be accessed", v.Name(), v.Driver())
+			if !force {
+				return err
+			}
+			logrus.Errorf("%v", err)
+		} else {
+			req := new(plugin.RemoveRequest)
+			req.Name = v.Name()
+			if err := v.plugin.RemoveVolume(req); err != nil {
+				if !force {
+					return errors.Wrapf(err, "volume %s could not be removed from plugin %s", v.Name(), v.Driver())
+				}
+				logrus.Errorf("Error removing volume %s from plugin %s: %v", v.Name(), v.Driver(), err)
+			}
+		}
+	}
+
 	// Set volume as invalid so it can no longer be used
 	v.valid = false
 
@@ -155,6 +155,19 @@ type State interface {
 	// answer is this: use this only very sparingly, and only if you really
 	// know what you're doing.
 	RewriteContainerConfig(ctr *Container, newCfg *ContainerConfig) error
+	// Rewrite a container's configuration, optionally changing its name
+	// from oldName to newName.
+	// Unlike RewriteContainerConfig, this is safe to use while other
+	// libpod instances are running, provided the container's lock is held.
+	// The new name must match the name in the new configuration and must
+	// not be in use by another container or pod. The name is updated
+	// atomically in all name registries, as well as in the pod and
+	// dependency references of the container. Pass the current name as
+	// both oldName and newName to keep it.
+	// Besides the name, only the resource limits in the OCI spec of the
+	// container may be altered; the container ID, pod, namespace and
+	// dependencies ABSOLUTELY CANNOT be changed.
+	SafeRewriteContainerConfig(ctr *Container, oldName, newName string, newCfg *ContainerConfig) error
 	// PLEASE READ THE DESCRIPTION FOR RewriteContainerConfig BEFORE USING.
 	// This function is identical to RewriteContainerConfig, save for the
 	// fact that it is used with pods instead.
@@ -1413,6 +1413,130 @@ func TestRewriteContainerConfigRewritesConfig(t *testing.T) {
 	})
 }
 
+func TestSafeRewriteContainerConfigNotInState(t *testing.T) {
+	runForAllStates(t, func(t *testing.T, state State, manager lock.Manager) {
+		testCtr, err := getTestCtr1(manager)
+		assert.NoError(t, err)
+
+		newConfig := *testCtr.config
+		newConfig.Name = "newname"
+		err = state.SafeRewriteContainerConfig(testCtr, testCtr.Name(), "newname", &newConfig)
+		assert.Error(t, err)
+	})
+}
+
+func TestSafeRewriteContainerConfigNameMismatchFails(t *testing.T) {
+	runForAllStates(t, func(t *testing.T, state State, manager lock.Manager) {
+		testCtr, err := getTestCtr1(manager)
+		assert.NoError(t, err)
+
+		err = state.AddContainer(testCtr)
+		assert.NoError(t, err)
+
+		err = state.SafeRewriteContainerConfig(testCtr, testCtr.Name(), "newname", testCtr.config)
+		assert.Error(t, err)
+	})
+}
+
+func TestSafeRewriteContainerConfigRenames(t *testing.T) {
+	runForAllStates(t, func(t *testing.T, state State, manager lock.Manager) {
+		testCtr1, err := getTestCtr1(manager)
+		assert.NoError(t, err)
+		testCtr2, err := getTestCtr2(manager)
+		assert.NoError(t, err)
+
+		testCtr2.config.Dependencies = []string{testCtr1.config.ID}
+
+		err = state.AddContainer(testCtr1)
+		assert.NoError(t, err)
+
+		err = state.AddContainer(testCtr2)
+		assert.NoError(t, err)
+
+		oldName := testCtr2.Name()
+		newConfig := *testCtr2.config
+		newConfig.Name = "newname"
+		err = state.SafeRewriteContainerConfig(testCtr2, oldName, "newname", &newConfig)
+		assert.NoError(t, err)
+
+		ctr, err := state.LookupContainer("newname")
+		assert.NoError(t, err)
+		assert.Equal(t, testCtr2.ID(), ctr.ID())
+		assert.Equal(t, "newname", ctr.Name())
+
+		_, err = state.LookupContainer(oldName)
+		assert.Error(t, err)
+
+		ids, err := state.ContainerInUse(testCtr1)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{testCtr2.ID()}, ids)
+
+		// The old name can be reused.
+		testCtr3, err := getTestContainer(strings.Repeat("3", 32), oldName, manager)
+		assert.NoError(t, err)
+		err = state.AddContainer(testCtr3)
+		assert.NoError(t, err)
+	})
+}
+
+func TestSafeRewriteContainerConfigNameInUseFails(t *testing.T) {
+	runForAllStates(t, func(t *testing.T, state State, manager lock.Manager) {
+		testCtr1, err := getTestCtr1(manager)
+		assert.NoError(t, err)
+		testCtr2, err := getTestCtr2(manager)
+		assert.NoError(t, err)
+		testPod, err := getTestPod(strings.Repeat("3", 32), "testpod", manager)
+		assert.NoError(t, err)
+
+		err = state.AddContainer(testCtr1)
+		assert.NoError(t, err)
+
+		err = state.AddContainer(testCtr2)
+		assert.NoError(t, err)
+
+		err = state.AddPod(testPod)
+		assert.NoError(t, err)
+
+		for _, name := range []string{testCtr1.Name(), testPod.Name()} {
+			newConfig := *testCtr2.config
+			newConfig.Name = name
+			err = state.SafeRewriteContainerConfig(testCtr2, testCtr2.Name(), name, &newConfig)
+			assert.Error(t, err)
+		}
+
+		ctr, err := state.LookupContainer(testCtr2.Name())
+		assert.NoError(t, err)
+		assert.Equal(t, testCtr2.ID(), ctr.ID())
+	})
+}
+
+func TestSafeRewriteContainerConfigRenamesPodContainer(t *testing.T) {
+	runForAllStates(t, func(t *testing.T, state State, manager lock.Manager) {
+		testPod, err := getTestPod1(manager)
+		assert.NoError(t, err)
+
+		testCtr, err := getTestCtr2(manager)
+		assert.NoError(t, err)
+		testCtr.config.Pod = testPod.ID()
+
+		err = state.AddPod(testPod)
+		assert.NoError(t, err)
+
+		err = state.AddContainerToPod(testPod, testCtr)
+		assert.NoError(t, err)
+
+		newConfig := *testCtr.config
+		newConfig.Name = "newname"
+		err = state.SafeRewriteContainerConfig(testCtr, testCtr.Name(), "newname", &newConfig)
+		assert.NoError(t, err)
+
+		ctrs, err := state.PodContainers(testPod)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(ctrs))
+		assert.Equal(t, "newname", ctrs[0].Name())
+	})
+}
+
 func TestRewritePodConfigDoesNotExist(t *testing.T) {
 	runForAllStates(t, func(t *testing.T, state State, manager lock.Manager) {
 		err := state.RewritePodConfig(&Pod{}, &PodConfig{})
@@ -5,6 +5,7 @@ import (
 
 	"github.com/containers/podman/v2/libpod/define"
 	"github.com/containers/podman/v2/libpod/lock"
+	"github.com/containers/podman/v2/libpod/plugin"
 )
 
 // Volume is a libpod named volume.
@@ -16,6 +17,7 @@ type Volume struct {
 	state  *VolumeState
 
 	valid   bool
+	plugin  *plugin.VolumePlugin
 	runtime *Runtime
 	lock    lock.Locker
 }
@@ -31,7 +33,9 @@ type VolumeConfig struct {
 	// The volume driver. Empty string or local does not activate a volume
 	// driver, all other volumes will.
 	Driver string `json:"volumeDriver"`
-	// The location the volume is mounted at.
+	// The location the volume is mounted at. Volumes using a volume
+	// plugin only know their location once mounted, see
+	// VolumeState.MountPoint.
 	MountPoint string `json:"mountPoint"`
 	// Time the volume was created.
 	CreatedTime time.Time `json:"createdAt,omitempty"`
@@ -49,8 +53,13 @@ type VolumeConfig struct {
 
 // VolumeState holds the volume's mutable state.
 // Volumes are not guaranteed to have a state. Only volumes using the Local
-// driver that have mount options set will create a state.
+// driver that have mount options set, or using a volume plugin, will create a
+// state.
 type VolumeState struct {
+	// MountPoint is the location the volume is mounted at on the host, as
+	// returned by the volume plugin. It is only set for volumes using a
+	// volume plugin, while they are mounted.
+	MountPoint string `json:"mountPoint,omitempty"`
 	// MountCount is the number of times this volume has been requested to
 	// be mounted.
 	// It is incremented on mount() and decremented on unmount().
@@ -84,6 +93,12 @@ func (v *Volume) Driver() string {
 	return v.config.Driver
 }
 
+// UsesVolumeDriver returns whether the volume is managed by a volume plugin
+// rather than the local driver.
+func (v *Volume) UsesVolumeDriver() bool {
+	return !(v.config.Driver == define.VolumeDriverLocal || v.config.Driver == "")
+}
+
 // Scope retrieves the volume's scope.
 // Libpod does not implement volume scoping, and this is provided solely for
 // Docker compatibility. It returns only "local".
@@ -100,8 +115,28 @@ func (v *Volume) Labels() map[string]string {
 	return labels
 }
 
-// MountPoint returns the volume's mountpoint on the host
-func (v *Volume) MountPoint() string {
+// MountPoint returns the volume's mountpoint on the host.
+// Volumes using a volume plugin only have a mountpoint while they are mounted.
+func (v *Volume) MountPoint() (string, error) {
+	// For the sake of performance, avoid locking unless we have to.
+	if v.UsesVolumeDriver() {
+		v.lock.Lock()
+		defer v.lock.Unlock()
+
+		if err := v.update(); err != nil {
+			return "", err
+		}
+	}
+
+	return v.mountPoint(), nil
+}
+
+// mountPoint returns the volume's mountpoint without locking.
+func (v *Volume) mountPoint() string {
+	if v.UsesVolumeDriver() {
+		return v.state.MountPoint
+	}
+
 	return v.config.MountPoint
 }
 
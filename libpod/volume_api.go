@@ -0,0 +1,82 @@
+package libpod
+
+import (
+	"io"
+
+	"github.com/containers/podman/v2/libpod/define"
+	"github.com/containers/podman/v2/pkg/rootless"
+	"github.com/containers/storage/pkg/archive"
+	"github.com/containers/storage/pkg/chrootarchive"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// Export writes an uncompressed tar archive of the contents of the volume to
+// the writer. Volumes that need a mount are mounted for the time of the
+// export.
+// As rootless, the owners of the files in the archive are the UIDs and GIDs
+// the containers see, not the ones on the host.
+func (v *Volume) Export(writer io.Writer) error {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	if !v.valid {
+		return define.ErrVolumeRemoved
+	}
+
+	if err := v.mount(); err != nil {
+		return errors.Wrapf(err, "error mounting volume %s", v.Name())
+	}
+	defer func() {
+		if err := v.unmount(false); err != nil {
+			logrus.Errorf("Error unmounting volume %s after export: %v", v.Name(), err)
+		}
+	}()
+
+	mountPoint := v.mountPoint()
+	reader, err := chrootarchive.Tar(mountPoint, &archive.TarOptions{
+		Compression: archive.Uncompressed,
+	}, mountPoint)
+	if err != nil {
+		return errors.Wrapf(err, "error archiving volume %s", v.Name())
+	}
+	defer reader.Close()
+
+	if _, err := io.Copy(writer, reader); err != nil {
+		return errors.Wrapf(err, "error archiving volume %s", v.Name())
+	}
+	return nil
+}
+
+// Import extracts the tar archive read from the reader into the volume. The
+// archive may be compressed. Files in the volume are replaced by the files of
+// the archive with the same path, all other files are kept. Volumes that need
+// a mount are mounted for the time of the import.
+// As rootless, the owners of the files in the archive are interpreted as the
+// UIDs and GIDs the containers see, which is how Export writes them.
+func (v *Volume) Import(reader io.Reader) error {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	if !v.valid {
+		return define.ErrVolumeRemoved
+	}
+
+	if err := v.mount(); err != nil {
+		return errors.Wrapf(err, "error mounting volume %s", v.Name())
+	}
+	defer func() {
+		if err := v.unmount(false); err != nil {
+			logrus.Errorf("Error unmounting volume %s after import: %v", v.Name(), err)
+		}
+	}()
+
+	mountPoint := v.mountPoint()
+	options := &archive.TarOptions{
+		InUserNS: rootless.IsRootless(),
+	}
+	if err := chrootarchive.UntarWithRoot(reader, mountPoint, options, mountPoint); err != nil {
+		return errors.Wrapf(err, "error extracting archive into volume %s", v.Name())
+	}
+	return nil
+}
@@ -12,7 +12,9 @@ type InspectVolumeData struct {
 	// Name is the name of the volume.
 	Name string `json:"Name"`
 	// Driver is the driver used to create the volume.
-	// This will be properly implemented in a future version.
+	// If set to "local" or "", the Local driver (Podman built-in code) is
+	// used to service the volume; otherwise, a volume plugin with the
+	// given name is used to mount and manage the volume.
 	Driver string `json:"Driver"`
 	// Mountpoint is the path on the host where the volume is mounted.
 	Mountpoint string `json:"Mountpoint"`
@@ -50,11 +52,16 @@ func (v *Volume) Inspect() (*InspectVolumeData, error) {
 		return nil, define.ErrVolumeRemoved
 	}
 
+	mountPoint, err := v.MountPoint()
+	if err != nil {
+		return nil, err
+	}
+
 	data := new(InspectVolumeData)
 
 	data.Name = v.config.Name
 	data.Driver = v.config.Driver
-	data.Mountpoint = v.config.MountPoint
+	data.Mountpoint = mountPoint
 	data.CreatedAt = v.config.CreatedTime
 	data.Labels = make(map[string]string)
 	for k, v := range v.config.Labels {
@@ -65,7 +72,6 @@ func (v *Volume) Inspect() (*InspectVolumeData, error) {
 	for k, v := range v.config.Options {
 		data.Options[k] = v
 	}
-	var err error
 	data.UID, err = v.UID()
 	if err != nil {
 		return nil, err
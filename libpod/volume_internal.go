@@ -22,12 +22,20 @@ func newVolume(runtime *Runtime) *Volume {
 
 // teardownStorage deletes the volume from volumePath
 func (v *Volume) teardownStorage() error {
+	// Volumes of volume plugins have no storage managed by us.
+	if v.UsesVolumeDriver() {
+		return nil
+	}
 	return os.RemoveAll(filepath.Join(v.runtime.config.Engine.VolumePath, v.Name()))
 }
 
 // Volumes with options set, or a filesystem type, or a device to mount need to
-// be mounted and unmounted.
+// be mounted and unmounted. Volumes using a volume plugin are always mounted
+// through the plugin.
 func (v *Volume) needsMount() bool {
+	if v.UsesVolumeDriver() {
+		return true
+	}
 	return len(v.config.Options) > 0 && v.config.Driver == define.VolumeDriverLocal
 }
 
@@ -7,12 +7,18 @@ import (
 	"strings"
 
 	"github.com/containers/podman/v2/libpod/define"
+	"github.com/containers/podman/v2/libpod/plugin"
 	"github.com/containers/podman/v2/pkg/rootless"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/sys/unix"
 )
 
+// pseudoCtrID is the container ID passed to volume plugins on mount and
+// unmount. Podman keeps its own mount counter, so the same ID is used for all
+// containers.
+const pseudoCtrID = "2f73349cfc4630255319c6c8dfc1b46a8996ace9d14d8e07563b165915918ec2"
+
 // mount mounts the volume if necessary.
 // A mount is necessary if a volume has any options set.
 // If a mount is necessary, v.state.MountCount will be incremented.
@@ -26,8 +32,9 @@ func (v *Volume) mount() error {
 		return nil
 	}
 
-	// We cannot mount volumes as rootless.
-	if rootless.IsRootless() {
+	// Volume plugins do their own mounting, and may be used by rootless
+	// Podman.
+	if rootless.IsRootless() && !v.UsesVolumeDriver() {
 		return errors.Wrapf(define.ErrRootless, "cannot mount volumes without root privileges")
 	}
 
@@ -44,6 +51,28 @@ func (v *Volume) mount() error {
 		return v.save()
 	}
 
+	// Volume plugins implement their own mount counter, based on the ID of
+	// the mounting container. We already keep a counter, so use the same
+	// pseudo container ID for every mount.
+	if v.UsesVolumeDriver() {
+		if v.plugin == nil {
+			return errors.Wrapf(define.ErrMissingPlugin, "volume plugin %s (needed by volume %s) missing", v.Driver(), v.Name())
+		}
+
+		req := new(plugin.MountRequest)
+		req.Name = v.Name()
+		req.ID = pseudoCtrID
+		mountPoint, err := v.plugin.MountVolume(req)
+		if err != nil {
+			return err
+		}
+
+		v.state.MountCount += 1
+		v.state.MountPoint = mountPoint
+		logrus.Debugf("Mounted volume %s at %s using plugin %s", v.Name(), mountPoint, v.Driver())
+		return v.save()
+	}
+
 	volDevice := v.config.Options["device"]
 	volType := v.config.Options["type"]
 	volOptions := v.config.Options["o"]
@@ -110,7 +139,7 @@ func (v *Volume) unmount(force bool) error {
 	}
 
 	// We cannot unmount volumes as rootless.
-	if rootless.IsRootless() {
+	if rootless.IsRootless() && !v.UsesVolumeDriver() {
 		// If force is set, just clear the counter and bail without
 		// error, so we can remove volumes from the state if they are in
 		// an awkward configuration.
@@ -132,6 +161,23 @@ func (v *Volume) unmount(force bool) error {
 	logrus.Debugf("Volume %s mount count now at %d", v.Name(), v.state.MountCount)
 
 	if v.state.MountCount == 0 {
+		if v.UsesVolumeDriver() {
+			if v.plugin == nil {
+				return errors.Wrapf(define.ErrMissingPlugin, "volume plugin %s (needed by volume %s) missing", v.Driver(), v.Name())
+			}
+
+			req := new(plugin.UnmountRequest)
+			req.Name = v.Name()
+			req.ID = pseudoCtrID
+			if err := v.plugin.UnmountVolume(req); err != nil {
+				return err
+			}
+
+			v.state.MountPoint = ""
+			logrus.Debugf("Unmounted volume %s using plugin %s", v.Name(), v.Driver())
+			return v.save()
+		}
+
 		// Unmount the volume
 		if err := unix.Unmount(v.config.MountPoint, unix.MNT_DETACH); err != nil {
 			if err == unix.EINVAL {
@@ -0,0 +1,87 @@
+package compat
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	commonAuth "github.com/containers/common/pkg/auth"
+	"github.com/containers/image/v5/docker"
+	imageAuth "github.com/containers/image/v5/pkg/docker/config"
+	"github.com/containers/image/v5/types"
+	"github.com/containers/podman/v2/libpod"
+	"github.com/containers/podman/v2/pkg/api/handlers/utils"
+	"github.com/containers/podman/v2/pkg/auth"
+	dockerTypes "github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/registry"
+	"github.com/gorilla/schema"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// Auth validates the credentials given in the request body against the
+// registry.  If the persist query parameter is set, the credentials are
+// stored in the auth file of the service and used by later requests which do
+// not provide credentials themselves.
+func Auth(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value("runtime").(*libpod.Runtime)
+	decoder := r.Context().Value("decoder").(*schema.Decoder)
+
+	query := struct {
+		Persist bool `schema:"persist"`
+	}{
+		// This is where you can override the golang default value for one of fields
+	}
+	if err := decoder.Decode(&query, r.URL.Query()); err != nil {
+		utils.Error(w, "Something went wrong.", http.StatusBadRequest, errors.Wrapf(err, "failed to parse parameters for %s", r.URL.String()))
+		return
+	}
+
+	var authConfig dockerTypes.AuthConfig
+	if err := json.NewDecoder(r.Body).Decode(&authConfig); err != nil {
+		utils.Error(w, "Something went wrong.", http.StatusBadRequest, errors.Wrap(err, "failed to parse request"))
+		return
+	}
+	credentials := auth.DockerAuthToImageAuth(authConfig)
+	username, password := credentials.Username, credentials.Password
+	server := registryFromServerAddress(authConfig.ServerAddress)
+
+	sys := &types.SystemContext{}
+	if runtimeSys := runtime.SystemContext(); runtimeSys != nil {
+		*sys = *runtimeSys
+	}
+	if err := docker.CheckAuth(r.Context(), sys, username, password, server); err != nil {
+		if _, ok := err.(docker.ErrUnauthorizedForCredentials); ok {
+			logrus.Debugf("error logging into %q: %v", server, err)
+			utils.Error(w, "Something went wrong.", http.StatusUnauthorized, errors.Errorf("login attempt to %s failed: invalid username/password", server))
+			return
+		}
+		utils.Error(w, "Something went wrong.", http.StatusInternalServerError, errors.Wrapf(err, "error authenticating creds for %q", server))
+		return
+	}
+
+	if query.Persist {
+		sys.AuthFilePath = commonAuth.GetDefaultAuthFile()
+		if err := imageAuth.SetAuthentication(sys, server, username, password); err != nil {
+			utils.InternalServerError(w, errors.Wrapf(err, "error storing credentials for %q", server))
+			return
+		}
+	}
+
+	utils.WriteResponse(w, http.StatusOK, registry.AuthenticateOKBody{
+		Status: "Login Succeeded",
+	})
+}
+
+// registryFromServerAddress returns the registry of a server address sent by
+// Docker clients, e.g. https://index.docker.io/v1/.  Docker Hub is used if
+// the address is empty.
+func registryFromServerAddress(address string) string {
+	address = strings.TrimPrefix(strings.TrimPrefix(address, "https://"), "http://")
+	server := strings.SplitN(address, "/", 2)[0]
+	switch server {
+	case "", "index.docker.io", "registry-1.docker.io":
+		return "docker.io"
+	}
+	return server
+}
@@ -1,12 +1,97 @@
 package compat
 
 import (
-	"errors"
+	"encoding/base64"
+	"encoding/json"
 	"net/http"
+	"os"
 
+	"github.com/containers/podman/v2/libpod"
+	"github.com/containers/podman/v2/libpod/define"
 	"github.com/containers/podman/v2/pkg/api/handlers/utils"
+	"github.com/containers/podman/v2/pkg/domain/entities"
+	"github.com/containers/podman/v2/pkg/domain/infra/abi"
+	"github.com/gorilla/schema"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
 )
 
+// Archive copies files from and into containers. HEAD returns the stat of
+// the path in the X-Docker-Container-Path-Stat header, GET a tar archive of
+// the path and PUT (or POST) extracts a tar archive into the path.
 func Archive(w http.ResponseWriter, r *http.Request) {
-	utils.Error(w, "not implemented", http.StatusNotImplemented, errors.New("not implemented"))
+	runtime := r.Context().Value("runtime").(*libpod.Runtime)
+	decoder := r.Context().Value("decoder").(*schema.Decoder)
+
+	query := struct {
+		Path                 string `schema:"path"`
+		Pause                bool   `schema:"pause"`
+		NoOverwriteDirNonDir bool   `schema:"noOverwriteDirNonDir"`
+		CopyUIDGID           bool   `schema:"copyUIDGID"`
+	}{
+		// Pausing is only done by default for the libpod endpoint.
+		Pause: utils.IsLibpodRequest(r),
+	}
+	if err := decoder.Decode(&query, r.URL.Query()); err != nil {
+		utils.Error(w, "Something went wrong.", http.StatusBadRequest, errors.Wrapf(err, "failed to parse parameters for %s", r.URL.String()))
+		return
+	}
+	if query.Path == "" {
+		utils.BadRequest(w, "path", query.Path, errors.New("must specify path parameter"))
+		return
+	}
+
+	name := utils.GetName(r)
+	containerEngine := abi.ContainerEngine{Libpod: runtime}
+	options := entities.ContainerArchiveOptions{
+		Pause:                query.Pause,
+		Chown:                query.CopyUIDGID,
+		NoOverwriteDirNonDir: query.NoOverwriteDirNonDir,
+	}
+
+	switch r.Method {
+	case http.MethodPut, http.MethodPost:
+		if err := containerEngine.ContainerCopyFromArchive(r.Context(), name, query.Path, r.Body, options); err != nil {
+			archiveError(w, name, err)
+			return
+		}
+		utils.WriteResponse(w, http.StatusOK, "")
+	default:
+		stat, err := containerEngine.ContainerStat(r.Context(), name, query.Path)
+		if err != nil {
+			archiveError(w, name, err)
+			return
+		}
+		statHeader, err := json.Marshal(stat)
+		if err != nil {
+			utils.InternalServerError(w, err)
+			return
+		}
+		w.Header().Set(entities.ContainerPathStatHeader, base64.StdEncoding.EncodeToString(statHeader))
+		if r.Method == http.MethodHead {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/x-tar")
+		w.WriteHeader(http.StatusOK)
+		if err := containerEngine.ContainerCopyToArchive(r.Context(), name, query.Path, w, options); err != nil {
+			// The status has been sent already.
+			logrus.Errorf("Error archiving %q of container %s: %v", query.Path, name, err)
+		}
+	}
+}
+
+func archiveError(w http.ResponseWriter, name string, err error) {
+	cause := errors.Cause(err)
+	switch {
+	case cause == define.ErrNoSuchCtr:
+		utils.ContainerNotFound(w, name, err)
+	case os.IsNotExist(cause):
+		utils.Error(w, "Something went wrong.", http.StatusNotFound, err)
+	case cause == define.ErrInvalidArg:
+		utils.Error(w, "Something went wrong.", http.StatusBadRequest, err)
+	default:
+		utils.InternalServerError(w, err)
+	}
 }
@@ -72,18 +72,27 @@ func LogsFromContainer(w http.ResponseWriter, r *http.Request) {
 
 	var until time.Time
 	if _, found := r.URL.Query()["until"]; found {
-		// FIXME: until != since but the logs backend does not yet support until.
-		since, err = util.ParseInputTime(query.Until)
+		until, err = util.ParseInputTime(query.Until)
 		if err != nil {
 			utils.BadRequest(w, "until", query.Until, err)
 			return
 		}
 	}
 
+	var stream string
+	switch {
+	case query.Stdout && !query.Stderr:
+		stream = logs.StdoutStream
+	case query.Stderr && !query.Stdout:
+		stream = logs.StderrStream
+	}
+
 	options := &logs.LogOptions{
 		Details:    true,
 		Follow:     query.Follow,
 		Since:      since,
+		Until:      until,
+		Stream:     stream,
 		Tail:       tail,
 		Timestamps: query.Timestamps,
 	}
@@ -118,12 +127,6 @@ func LogsFromContainer(w http.ResponseWriter, r *http.Request) {
 	}
 
 	for line := range logChannel {
-		if _, found := r.URL.Query()["until"]; found {
-			if line.Time.After(until) {
-				break
-			}
-		}
-
 		// Reset buffer we're ready to loop again
 		frame.Reset()
 		switch line.Device {
@@ -0,0 +1,52 @@
+package compat
+
+import (
+	"net/http"
+
+	"github.com/containers/podman/v2/libpod"
+	"github.com/containers/podman/v2/libpod/define"
+	"github.com/containers/podman/v2/pkg/api/handlers/utils"
+	"github.com/gorilla/schema"
+	"github.com/pkg/errors"
+)
+
+// RenameContainer renames a container. It is used by both the compat and the
+// libpod endpoints.
+func RenameContainer(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value("runtime").(*libpod.Runtime)
+	decoder := r.Context().Value("decoder").(*schema.Decoder)
+
+	query := struct {
+		Name string `schema:"name"`
+	}{}
+	if err := decoder.Decode(&query, r.URL.Query()); err != nil {
+		utils.Error(w, "Something went wrong.", http.StatusBadRequest, errors.Wrapf(err, "failed to parse parameters for %s", r.URL.String()))
+		return
+	}
+	if query.Name == "" {
+		utils.BadRequest(w, "name", query.Name, errors.New("a new name for the container must be given"))
+		return
+	}
+
+	// /{version}/containers/(name)/rename
+	name := utils.GetName(r)
+	ctr, err := runtime.LookupContainer(name)
+	if err != nil {
+		utils.ContainerNotFound(w, name, err)
+		return
+	}
+
+	if err := runtime.RenameContainer(r.Context(), ctr, query.Name); err != nil {
+		switch errors.Cause(err) {
+		case define.ErrCtrExists, define.ErrPodExists:
+			utils.Error(w, "Something went wrong.", http.StatusConflict, err)
+		case define.ErrInvalidArg:
+			utils.Error(w, "Something went wrong.", http.StatusBadRequest, err)
+		default:
+			utils.InternalServerError(w, err)
+		}
+		return
+	}
+
+	utils.WriteResponse(w, http.StatusNoContent, "")
+}
@@ -0,0 +1,126 @@
+package compat
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/containers/podman/v2/libpod"
+	"github.com/containers/podman/v2/libpod/define"
+	"github.com/containers/podman/v2/pkg/api/handlers/utils"
+	"github.com/docker/docker/api/types/container"
+	spec "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+)
+
+// UpdateContainer updates the resource limits of a container.
+func UpdateContainer(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value("runtime").(*libpod.Runtime)
+
+	var updateConfig container.UpdateConfig
+	if err := json.NewDecoder(r.Body).Decode(&updateConfig); err != nil {
+		utils.Error(w, "Something went wrong.", http.StatusBadRequest, errors.Wrap(err, "Decode()"))
+		return
+	}
+
+	// /{version}/containers/(name)/update
+	name := utils.GetName(r)
+	ctr, err := runtime.LookupContainer(name)
+	if err != nil {
+		utils.ContainerNotFound(w, name, err)
+		return
+	}
+
+	if err := ctr.Update(resourcesFromUpdateConfig(&updateConfig)); err != nil {
+		UpdateError(w, err)
+		return
+	}
+
+	utils.WriteResponse(w, http.StatusOK, container.ContainerUpdateOKBody{Warnings: []string{}})
+}
+
+// UpdateError writes the response for an error returned when updating the
+// resource limits of a container.
+func UpdateError(w http.ResponseWriter, err error) {
+	switch errors.Cause(err) {
+	case define.ErrInvalidArg:
+		utils.Error(w, "Something went wrong.", http.StatusBadRequest, err)
+	case define.ErrCtrStateInvalid, define.ErrNoCgroups:
+		utils.Error(w, "Something went wrong.", http.StatusConflict, err)
+	default:
+		utils.InternalServerError(w, err)
+	}
+}
+
+// resourcesFromUpdateConfig converts the resource limits of a Docker update
+// request into OCI resource limits. Unset (zero) values are left unchanged.
+func resourcesFromUpdateConfig(updateConfig *container.UpdateConfig) *spec.LinuxResources {
+	resources := &spec.LinuxResources{
+		CPU:     &spec.LinuxCPU{},
+		Memory:  &spec.LinuxMemory{},
+		BlockIO: &spec.LinuxBlockIO{},
+	}
+
+	if updateConfig.CPUShares > 0 {
+		shares := uint64(updateConfig.CPUShares)
+		resources.CPU.Shares = &shares
+	}
+	if updateConfig.NanoCPUs > 0 {
+		period := uint64(100000)
+		quota := updateConfig.NanoCPUs * int64(period) / 1e9
+		resources.CPU.Period = &period
+		resources.CPU.Quota = &quota
+	}
+	if updateConfig.CPUPeriod > 0 {
+		period := uint64(updateConfig.CPUPeriod)
+		resources.CPU.Period = &period
+	}
+	if updateConfig.CPUQuota != 0 {
+		quota := updateConfig.CPUQuota
+		resources.CPU.Quota = &quota
+	}
+	if updateConfig.CPURealtimePeriod > 0 {
+		period := uint64(updateConfig.CPURealtimePeriod)
+		resources.CPU.RealtimePeriod = &period
+	}
+	if updateConfig.CPURealtimeRuntime != 0 {
+		runtime := updateConfig.CPURealtimeRuntime
+		resources.CPU.RealtimeRuntime = &runtime
+	}
+	resources.CPU.Cpus = updateConfig.CpusetCpus
+	resources.CPU.Mems = updateConfig.CpusetMems
+
+	if updateConfig.Memory > 0 {
+		memory := updateConfig.Memory
+		resources.Memory.Limit = &memory
+	}
+	if updateConfig.MemoryReservation > 0 {
+		reservation := updateConfig.MemoryReservation
+		resources.Memory.Reservation = &reservation
+	}
+	if updateConfig.MemorySwap != 0 {
+		swap := updateConfig.MemorySwap
+		resources.Memory.Swap = &swap
+	}
+	if updateConfig.MemorySwappiness != nil && *updateConfig.MemorySwappiness >= 0 {
+		swappiness := uint64(*updateConfig.MemorySwappiness)
+		resources.Memory.Swappiness = &swappiness
+	}
+	resources.Memory.DisableOOMKiller = updateConfig.OomKillDisable
+
+	if updateConfig.PidsLimit != nil {
+		// Docker treats 0 as unlimited, the OCI runtimes ignore a limit of
+		// 0 on update and remove the limit on -1.
+		limit := *updateConfig.PidsLimit
+		if limit == 0 {
+			limit = -1
+		}
+		resources.Pids = &spec.LinuxPids{Limit: limit}
+	}
+
+	if updateConfig.BlkioWeight > 0 {
+		weight := updateConfig.BlkioWeight
+		resources.BlockIO.Weight = &weight
+	}
+
+	return resources
+}
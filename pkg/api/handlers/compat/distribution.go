@@ -0,0 +1,103 @@
+package compat
+
+import (
+	"net/http"
+
+	"github.com/containers/image/v5/docker"
+	"github.com/containers/image/v5/types"
+	"github.com/containers/podman/v2/libpod"
+	"github.com/containers/podman/v2/pkg/api/handlers/utils"
+	"github.com/containers/podman/v2/pkg/auth"
+	"github.com/containers/podman/v2/pkg/domain/entities"
+	"github.com/containers/podman/v2/pkg/domain/infra/abi"
+	"github.com/docker/distribution/registry/api/errcode"
+	v2 "github.com/docker/distribution/registry/api/v2"
+	"github.com/docker/docker/api/types/registry"
+	"github.com/gorilla/schema"
+	"github.com/pkg/errors"
+)
+
+// DistributionInspect returns the descriptor of the manifest and the
+// platforms of an image in a registry without pulling it.
+func DistributionInspect(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value("runtime").(*libpod.Runtime)
+	decoder := r.Context().Value("decoder").(*schema.Decoder)
+	query := struct {
+		TLSVerify bool `schema:"tlsVerify"`
+	}{
+		TLSVerify: true,
+	}
+	if err := decoder.Decode(&query, r.URL.Query()); err != nil {
+		utils.Error(w, "Something went wrong.", http.StatusBadRequest, errors.Wrapf(err, "failed to parse parameters for %s", r.URL.String()))
+		return
+	}
+
+	authConf, authfile, key, err := auth.GetCredentials(r)
+	if err != nil {
+		utils.Error(w, "failed to retrieve repository credentials", http.StatusBadRequest, errors.Wrapf(err, "failed to parse %q header for %s", key, r.URL.String()))
+		return
+	}
+	defer auth.RemoveAuthfile(authfile)
+
+	options := entities.ImageRegistryInspectOptions{
+		Authfile: authfile,
+	}
+	if authConf != nil {
+		options.Username = authConf.Username
+		options.Password = authConf.Password
+	}
+	if _, found := r.URL.Query()["tlsVerify"]; found {
+		options.SkipTLSVerify = types.NewOptionalBool(!query.TLSVerify)
+	}
+
+	name := utils.GetName(r)
+	imageEngine := abi.ImageEngine{Libpod: runtime}
+	report, err := imageEngine.RegistryInspect(r.Context(), name, options)
+	if err != nil {
+		switch cause := errors.Cause(err); {
+		case isUnauthorized(cause):
+			utils.Error(w, "Something went wrong.", http.StatusUnauthorized, err)
+		case isManifestUnknown(cause):
+			utils.Error(w, "Something went wrong.", http.StatusNotFound, err)
+		default:
+			utils.InternalServerError(w, err)
+		}
+		return
+	}
+
+	if utils.IsLibpodRequest(r) {
+		utils.WriteResponse(w, http.StatusOK, report)
+		return
+	}
+	utils.WriteResponse(w, http.StatusOK, registry.DistributionInspect{
+		Descriptor: report.Descriptor,
+		Platforms:  report.Platforms,
+	})
+}
+
+func isUnauthorized(err error) bool {
+	_, ok := err.(docker.ErrUnauthorizedForCredentials)
+	return ok
+}
+
+// isManifestUnknown returns true if the registry reported that the repository
+// or the manifest of the image does not exist.
+func isManifestUnknown(err error) bool {
+	var codes errcode.Errors
+	switch e := err.(type) {
+	case errcode.Errors:
+		codes = e
+	case errcode.Error:
+		codes = errcode.Errors{e}
+	default:
+		return false
+	}
+	for _, c := range codes {
+		if e, ok := c.(errcode.Error); ok {
+			if e.Code == v2.ErrorCodeManifestUnknown || e.Code == v2.ErrorCodeNameUnknown {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/containers/podman/v2/pkg/domain/entities"
 	"github.com/containers/storage/pkg/archive"
 	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/registry"
 )
 
 // Create container
@@ -77,3 +78,24 @@ type swagCompatNetworkDisconnectRequest struct {
 	// in:body
 	Body struct{ types.NetworkDisconnect }
 }
+
+// Auth configuration
+// swagger:model AuthConfig
+type swagCompatAuthConfig struct {
+	// in:body
+	Body struct{ types.AuthConfig }
+}
+
+// Auth
+// swagger:response SystemAuthResponse
+type swagCompatAuthResponse struct {
+	// in:body
+	Body struct{ registry.AuthenticateOKBody }
+}
+
+// Distribution inspect
+// swagger:response DistributionInspectResponse
+type swagCompatDistributionInspectResponse struct {
+	// in:body
+	Body struct{ registry.DistributionInspect }
+}
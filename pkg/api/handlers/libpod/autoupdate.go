@@ -0,0 +1,52 @@
+package libpod
+
+import (
+	"net/http"
+
+	"github.com/containers/podman/v2/libpod"
+	"github.com/containers/podman/v2/pkg/api/handlers/utils"
+	"github.com/containers/podman/v2/pkg/auth"
+	"github.com/containers/podman/v2/pkg/domain/entities"
+	"github.com/containers/podman/v2/pkg/domain/infra/abi"
+	"github.com/gorilla/schema"
+	"github.com/pkg/errors"
+)
+
+// AutoUpdate updates the containers according to their auto-update policy.
+// The errors of containers which failed to update are part of the response.
+func AutoUpdate(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value("runtime").(*libpod.Runtime)
+	decoder := r.Context().Value("decoder").(*schema.Decoder)
+	query := struct {
+		Rollback bool `schema:"rollback"`
+		DryRun   bool `schema:"dryRun"`
+	}{
+		// override any golang type defaults
+	}
+	if err := decoder.Decode(&query, r.URL.Query()); err != nil {
+		utils.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest,
+			errors.Wrapf(err, "failed to parse parameters for %s", r.URL.String()))
+		return
+	}
+
+	_, authfile, key, err := auth.GetCredentials(r)
+	if err != nil {
+		utils.Error(w, "failed to retrieve repository credentials", http.StatusBadRequest, errors.Wrapf(err, "failed to parse %q header for %s", key, r.URL.String()))
+		return
+	}
+	defer auth.RemoveAuthfile(authfile)
+
+	containerEngine := abi.ContainerEngine{Libpod: runtime}
+	options := entities.AutoUpdateOptions{
+		Authfile: authfile,
+		Rollback: query.Rollback,
+		DryRun:   query.DryRun,
+	}
+	reports, failures := containerEngine.AutoUpdate(r.Context(), options)
+
+	response := entities.AutoUpdateResponse{Reports: reports}
+	for _, err := range failures {
+		response.Errors = append(response.Errors, err.Error())
+	}
+	utils.WriteResponse(w, http.StatusOK, response)
+}
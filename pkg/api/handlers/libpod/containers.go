@@ -1,6 +1,7 @@
 package libpod
 
 import (
+	"encoding/json"
 	"io/ioutil"
 	"net/http"
 	"os"
@@ -10,10 +11,12 @@ import (
 	"github.com/containers/podman/v2/libpod/define"
 	"github.com/containers/podman/v2/pkg/api/handlers/compat"
 	"github.com/containers/podman/v2/pkg/api/handlers/utils"
+	"github.com/containers/podman/v2/pkg/checkpoint"
 	"github.com/containers/podman/v2/pkg/domain/entities"
 	"github.com/containers/podman/v2/pkg/domain/infra/abi"
 	"github.com/containers/podman/v2/pkg/ps"
 	"github.com/gorilla/schema"
+	spec "github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 )
@@ -203,11 +206,14 @@ func Checkpoint(w http.ResponseWriter, r *http.Request) {
 	var targetFile string
 	decoder := r.Context().Value("decoder").(*schema.Decoder)
 	query := struct {
-		Keep           bool `schema:"keep"`
-		LeaveRunning   bool `schema:"leaveRunning"`
-		TCPEstablished bool `schema:"tcpEstablished"`
-		Export         bool `schema:"export"`
-		IgnoreRootFS   bool `schema:"ignoreRootFS"`
+		Keep           bool   `schema:"keep"`
+		LeaveRunning   bool   `schema:"leaveRunning"`
+		TCPEstablished bool   `schema:"tcpEstablished"`
+		Export         bool   `schema:"export"`
+		IgnoreRootFS   bool   `schema:"ignoreRootFS"`
+		PreCheckPoint  bool   `schema:"preCheckpoint"`
+		WithPrevious   bool   `schema:"withPrevious"`
+		CreateImage    string `schema:"createImage"`
 	}{
 		// override any golang type defaults
 	}
@@ -242,6 +248,9 @@ func Checkpoint(w http.ResponseWriter, r *http.Request) {
 		KeepRunning:    query.LeaveRunning,
 		TCPEstablished: query.TCPEstablished,
 		IgnoreRootfs:   query.IgnoreRootFS,
+		PreCheckPoint:  query.PreCheckPoint,
+		WithPrevious:   query.WithPrevious,
+		CreateImage:    query.CreateImage,
 	}
 	if query.Export {
 		options.TargetFile = targetFile
@@ -285,11 +294,35 @@ func Restore(w http.ResponseWriter, r *http.Request) {
 			errors.Wrapf(err, "failed to parse parameters for %s", r.URL.String()))
 		return
 	}
+	options := libpod.ContainerCheckpointOptions{
+		Keep:            query.Keep,
+		TCPEstablished:  query.TCPEstablished,
+		IgnoreRootfs:    query.IgnoreRootFS,
+		IgnoreStaticIP:  query.IgnoreStaticIP,
+		IgnoreStaticMAC: query.IgnoreStaticMAC,
+	}
 	name := utils.GetName(r)
 	runtime := r.Context().Value("runtime").(*libpod.Runtime)
 	ctr, err := runtime.LookupContainer(name)
 	if err != nil {
-		utils.ContainerNotFound(w, name, err)
+		if errors.Cause(err) != define.ErrNoSuchCtr || query.Import || !checkpoint.CRIsCheckpointImage(r.Context(), runtime, name) {
+			utils.ContainerNotFound(w, name, err)
+			return
+		}
+		// The name may be the name of a checkpoint image
+		ctr, err = checkpoint.CRRestoreFromImage(r.Context(), runtime, name, options)
+		switch {
+		case ctr == nil && err != nil:
+			utils.ContainerNotFound(w, name, err)
+		case err != nil:
+			utils.InternalServerError(w, err)
+		default:
+			utils.WriteResponse(w, http.StatusOK, entities.RestoreReport{Id: ctr.ID()})
+		}
+		return
+	}
+	if query.IgnoreRootFS && !query.Import {
+		utils.Error(w, "Something went wrong.", http.StatusBadRequest, errors.Errorf("ignoreRootFS can only be used with import or a checkpoint image"))
 		return
 	}
 	if query.Import {
@@ -306,13 +339,6 @@ func Restore(w http.ResponseWriter, r *http.Request) {
 		targetFile = t.Name()
 	}
 
-	options := libpod.ContainerCheckpointOptions{
-		Keep:            query.Keep,
-		TCPEstablished:  query.TCPEstablished,
-		IgnoreRootfs:    query.IgnoreRootFS,
-		IgnoreStaticIP:  query.IgnoreStaticIP,
-		IgnoreStaticMAC: query.IgnoreStaticMAC,
-	}
 	if query.Import {
 		options.TargetFile = targetFile
 		options.Name = query.Name
@@ -345,6 +371,28 @@ func InitContainer(w http.ResponseWriter, r *http.Request) {
 	utils.WriteResponse(w, http.StatusNoContent, "")
 }
 
+func UpdateContainer(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value("runtime").(*libpod.Runtime)
+
+	var resources spec.LinuxResources
+	if err := json.NewDecoder(r.Body).Decode(&resources); err != nil {
+		utils.Error(w, "Something went wrong.", http.StatusBadRequest, errors.Wrap(err, "Decode()"))
+		return
+	}
+
+	name := utils.GetName(r)
+	ctr, err := runtime.LookupContainer(name)
+	if err != nil {
+		utils.ContainerNotFound(w, name, err)
+		return
+	}
+	if err := ctr.Update(&resources); err != nil {
+		compat.UpdateError(w, err)
+		return
+	}
+	utils.WriteResponse(w, http.StatusNoContent, "")
+}
+
 func ShouldRestart(w http.ResponseWriter, r *http.Request) {
 	runtime := r.Context().Value("runtime").(*libpod.Runtime)
 	// Now use the ABI implementation to prevent us from having duplicate
@@ -0,0 +1,143 @@
+package libpod
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/containers/image/v5/types"
+	"github.com/containers/podman/v2/libpod"
+	"github.com/containers/podman/v2/libpod/define"
+	"github.com/containers/podman/v2/pkg/api/handlers/utils"
+	"github.com/containers/podman/v2/pkg/auth"
+	"github.com/containers/podman/v2/pkg/channel"
+	"github.com/containers/podman/v2/pkg/domain/entities"
+	"github.com/containers/podman/v2/pkg/domain/infra/abi"
+	"github.com/gorilla/schema"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// Runlabel executes the command described by a label of an image on the
+// server. The output of the command is streamed as it is written.
+func Runlabel(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value("runtime").(*libpod.Runtime)
+	decoder := r.Context().Value("decoder").(*schema.Decoder)
+	query := struct {
+		Image     string   `schema:"image"`
+		Label     string   `schema:"label"`
+		Args      []string `schema:"args"`
+		Display   bool     `schema:"display"`
+		Name      string   `schema:"name"`
+		Opt1      string   `schema:"opt1"`
+		Opt2      string   `schema:"opt2"`
+		Opt3      string   `schema:"opt3"`
+		Pull      bool     `schema:"pull"`
+		Quiet     bool     `schema:"quiet"`
+		Replace   bool     `schema:"replace"`
+		TLSVerify bool     `schema:"tlsVerify"`
+	}{
+		Pull:      true,
+		TLSVerify: true,
+	}
+	if err := decoder.Decode(&query, r.URL.Query()); err != nil {
+		utils.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest,
+			errors.Wrapf(err, "failed to parse parameters for %s", r.URL.String()))
+		return
+	}
+	if query.Image == "" {
+		utils.BadRequest(w, "image", query.Image, errors.New("must specify an image"))
+		return
+	}
+	if query.Label == "" {
+		utils.BadRequest(w, "label", query.Label, errors.New("must specify a label"))
+		return
+	}
+
+	authConf, authfile, key, err := auth.GetCredentials(r)
+	if err != nil {
+		utils.Error(w, "failed to retrieve repository credentials", http.StatusBadRequest, errors.Wrapf(err, "failed to parse %q header for %s", key, r.URL.String()))
+		return
+	}
+	defer auth.RemoveAuthfile(authfile)
+
+	writer := channel.NewWriter(make(chan []byte, 1))
+	defer writer.Close()
+
+	options := entities.ContainerRunlabelOptions{
+		Authfile:  authfile,
+		Display:   query.Display,
+		Replace:   query.Replace,
+		Name:      query.Name,
+		Optional1: query.Opt1,
+		Optional2: query.Opt2,
+		Optional3: query.Opt3,
+		Pull:      query.Pull,
+		Quiet:     query.Quiet,
+		Writer:    writer,
+	}
+	if authConf != nil && authConf.Username != "" && authConf.Password != "" {
+		options.Credentials = fmt.Sprintf("%s:%s", authConf.Username, authConf.Password)
+	}
+	if _, found := r.URL.Query()["tlsVerify"]; found {
+		options.SkipTLSVerify = types.NewOptionalBool(!query.TLSVerify)
+	}
+
+	containerEngine := abi.ContainerEngine{Libpod: runtime}
+	runErr := make(chan error, 1)
+	go func() {
+		runErr <- containerEngine.ContainerRunlabel(r.Context(), query.Label, query.Image, query.Args, options)
+	}()
+
+	flush := func() {
+		if flusher, ok := w.(http.Flusher); ok {
+			flusher.Flush()
+		}
+	}
+
+	// The status is sent along with the first output of the command, so
+	// errors occurring before, e.g. a missing image, are reported as such.
+	var started bool
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(true)
+	send := func(report entities.ContainerRunlabelReport) {
+		if !started {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			started = true
+		}
+		if err := enc.Encode(report); err != nil {
+			logrus.Warnf("Failed to json encode runlabel report: %v", err)
+		}
+		flush()
+	}
+
+	for {
+		select {
+		case output := <-writer.Chan():
+			send(entities.ContainerRunlabelReport{Stream: string(output)})
+		case err := <-runErr:
+			// Send the output written before the command exited.
+			for len(writer.Chan()) > 0 {
+				send(entities.ContainerRunlabelReport{Stream: string(<-writer.Chan())})
+			}
+			switch {
+			case err == nil && !started:
+				w.WriteHeader(http.StatusOK)
+			case err == nil:
+			case started:
+				send(entities.ContainerRunlabelReport{Error: err.Error()})
+			case errors.Cause(err) == define.ErrNoSuchImage:
+				utils.ImageNotFound(w, query.Image, err)
+			case errors.Cause(err) == define.ErrInvalidArg:
+				utils.Error(w, "Something went wrong.", http.StatusBadRequest, err)
+			default:
+				utils.InternalServerError(w, err)
+			}
+			return
+		case <-r.Context().Done():
+			// Client has closed connection
+			return
+		}
+	}
+}
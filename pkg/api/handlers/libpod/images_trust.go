@@ -0,0 +1,126 @@
+package libpod
+
+import (
+	"net/http"
+
+	"github.com/containers/podman/v2/libpod"
+	"github.com/containers/podman/v2/pkg/api/handlers/utils"
+	"github.com/containers/podman/v2/pkg/domain/entities"
+	"github.com/containers/podman/v2/pkg/domain/infra/abi"
+	"github.com/containers/podman/v2/pkg/util"
+	"github.com/gorilla/schema"
+	"github.com/pkg/errors"
+)
+
+// ShowTrust returns the trust policy of the server.
+func ShowTrust(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value("runtime").(*libpod.Runtime)
+	decoder := r.Context().Value("decoder").(*schema.Decoder)
+	query := struct {
+		PolicyPath   string `schema:"policyPath"`
+		RegistryPath string `schema:"registryPath"`
+		Raw          bool   `schema:"raw"`
+	}{
+		// override any golang type defaults
+	}
+	if err := decoder.Decode(&query, r.URL.Query()); err != nil {
+		utils.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest,
+			errors.Wrapf(err, "failed to parse parameters for %s", r.URL.String()))
+		return
+	}
+
+	imageEngine := abi.ImageEngine{Libpod: runtime}
+	options := entities.ShowTrustOptions{
+		PolicyPath:   query.PolicyPath,
+		RegistryPath: query.RegistryPath,
+		Raw:          query.Raw,
+	}
+	report, err := imageEngine.ShowTrust(r.Context(), nil, options)
+	if err != nil {
+		utils.InternalServerError(w, err)
+		return
+	}
+	utils.WriteResponse(w, http.StatusOK, report)
+}
+
+// SetTrust sets the default trust policy or the trust policy of a registry
+// on the server.
+func SetTrust(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value("runtime").(*libpod.Runtime)
+	decoder := r.Context().Value("decoder").(*schema.Decoder)
+	query := struct {
+		Scope       string   `schema:"scope"`
+		Type        string   `schema:"type"`
+		PubKeysFile []string `schema:"pubKeysFile"`
+		PolicyPath  string   `schema:"policyPath"`
+	}{
+		Type: "signedBy",
+	}
+	if err := decoder.Decode(&query, r.URL.Query()); err != nil {
+		utils.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest,
+			errors.Wrapf(err, "failed to parse parameters for %s", r.URL.String()))
+		return
+	}
+	if query.Scope == "" {
+		utils.BadRequest(w, "scope", query.Scope, errors.New("must specify a registry or default"))
+		return
+	}
+	validTrustTypes := []string{"accept", "insecureAcceptAnything", "reject", "signedBy"}
+	if !util.StringInSlice(query.Type, validTrustTypes) {
+		utils.BadRequest(w, "type", query.Type, errors.New("must be one of accept, reject or signedBy"))
+		return
+	}
+
+	imageEngine := abi.ImageEngine{Libpod: runtime}
+	options := entities.SetTrustOptions{
+		PolicyPath:  query.PolicyPath,
+		PubKeysFile: query.PubKeysFile,
+		Type:        query.Type,
+	}
+	if err := imageEngine.SetTrust(r.Context(), []string{query.Scope}, options); err != nil {
+		utils.InternalServerError(w, err)
+		return
+	}
+	utils.WriteResponse(w, http.StatusNoContent, "")
+}
+
+// SignImages signs images with a GPG key of the server and stores the
+// signatures on the server.
+func SignImages(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value("runtime").(*libpod.Runtime)
+	decoder := r.Context().Value("decoder").(*schema.Decoder)
+	query := struct {
+		Images    []string `schema:"images"`
+		SignBy    string   `schema:"signBy"`
+		Directory string   `schema:"directory"`
+		CertDir   string   `schema:"certDir"`
+	}{
+		// override any golang type defaults
+	}
+	if err := decoder.Decode(&query, r.URL.Query()); err != nil {
+		utils.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest,
+			errors.Wrapf(err, "failed to parse parameters for %s", r.URL.String()))
+		return
+	}
+	if len(query.Images) == 0 {
+		utils.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest, errors.New("must specify at least one image"))
+		return
+	}
+	if query.SignBy == "" {
+		utils.BadRequest(w, "signBy", query.SignBy, errors.New("must specify an identity"))
+		return
+	}
+
+	imageEngine := abi.ImageEngine{Libpod: runtime}
+	options := entities.SignOptions{
+		Directory: query.Directory,
+		SignBy:    query.SignBy,
+		CertDir:   query.CertDir,
+	}
+	report, err := imageEngine.Sign(r.Context(), query.Images, options)
+	if err != nil {
+		utils.InternalServerError(w, err)
+		return
+	}
+	utils.WriteResponse(w, http.StatusOK, report)
+}
@@ -28,6 +28,13 @@ type swagInspectManifestResponse struct {
 	Body manifest.List
 }
 
+// Inspect image in a registry
+// swagger:response RegistryInspectReport
+type swagRegistryInspectResponse struct {
+	// in:body
+	Body entities.ImageRegistryInspectReport
+}
+
 // Kill Pod
 // swagger:response PodKillReport
 type swagKillPodResponse struct {
@@ -119,6 +126,27 @@ type swagNetworkCreateReport struct {
 	Body entities.NetworkCreateReport
 }
 
+// Secret create
+// swagger:response SecretCreateResponse
+type swagSecretCreateResponse struct {
+	// in:body
+	Body entities.SecretCreateReport
+}
+
+// Secret list
+// swagger:response SecretListResponse
+type swagSecretListResponse struct {
+	// in:body
+	Body []entities.SecretInfoReport
+}
+
+// Secret inspect
+// swagger:response SecretInspectResponse
+type swagSecretInspectResponse struct {
+	// in:body
+	Body entities.SecretInfoReport
+}
+
 func ServeSwagger(w http.ResponseWriter, r *http.Request) {
 	path := DefaultPodmanSwaggerSpec
 	if p, found := os.LookupEnv("PODMAN_SWAGGER_SPEC"); found {
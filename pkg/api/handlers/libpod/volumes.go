@@ -12,6 +12,7 @@ import (
 	"github.com/containers/podman/v2/pkg/domain/infra/abi/parse"
 	"github.com/gorilla/schema"
 	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
 )
 
 func CreateVolume(w http.ResponseWriter, r *http.Request) {
@@ -97,10 +98,15 @@ func InspectVolume(w http.ResponseWriter, r *http.Request) {
 		utils.Error(w, "Error fetching volume GID", http.StatusInternalServerError, err)
 		return
 	}
+	mountPoint, err := vol.MountPoint()
+	if err != nil {
+		utils.Error(w, "Error fetching volume mountpoint", http.StatusInternalServerError, err)
+		return
+	}
 	volResponse := entities.VolumeConfigResponse{
 		Name:       vol.Name(),
 		Driver:     vol.Driver(),
-		Mountpoint: vol.MountPoint(),
+		Mountpoint: mountPoint,
 		CreatedAt:  vol.CreatedTime(),
 		Labels:     vol.Labels(),
 		Scope:      vol.Scope(),
@@ -152,10 +158,15 @@ func ListVolumes(w http.ResponseWriter, r *http.Request) {
 			utils.Error(w, "Error fetching volume GID", http.StatusInternalServerError, err)
 			return
 		}
+		mountPoint, err := v.MountPoint()
+		if err != nil {
+			utils.Error(w, "Error fetching volume mountpoint", http.StatusInternalServerError, err)
+			return
+		}
 		config := entities.VolumeConfigResponse{
 			Name:       v.Name(),
 			Driver:     v.Driver(),
-			Mountpoint: v.MountPoint(),
+			Mountpoint: mountPoint,
 			CreatedAt:  v.CreatedTime(),
 			Labels:     v.Labels(),
 			Scope:      v.Scope(),
@@ -226,3 +237,55 @@ func RemoveVolume(w http.ResponseWriter, r *http.Request) {
 	}
 	utils.WriteResponse(w, http.StatusNoContent, "")
 }
+
+// ExportVolume writes an uncompressed tar archive of the contents of the
+// volume.
+func ExportVolume(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value("runtime").(*libpod.Runtime)
+	name := utils.GetName(r)
+	vol, err := runtime.LookupVolume(name)
+	if err != nil {
+		utils.VolumeNotFound(w, name, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/x-tar")
+	writer := &exportResponseWriter{ResponseWriter: w}
+	if err := vol.Export(writer); err != nil {
+		if !writer.started {
+			utils.InternalServerError(w, err)
+			return
+		}
+		// The status has been sent already.
+		logrus.Errorf("Error exporting volume %s: %v", name, err)
+	}
+}
+
+// exportResponseWriter records whether writing the response has started.
+// Errors that occur before can still be returned to the client.
+type exportResponseWriter struct {
+	http.ResponseWriter
+	started bool
+}
+
+func (e *exportResponseWriter) Write(p []byte) (int, error) {
+	e.started = true
+	return e.ResponseWriter.Write(p)
+}
+
+// ImportVolume extracts the tar archive in the request body into the volume.
+func ImportVolume(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value("runtime").(*libpod.Runtime)
+	name := utils.GetName(r)
+	vol, err := runtime.LookupVolume(name)
+	if err != nil {
+		utils.VolumeNotFound(w, name, err)
+		return
+	}
+
+	if err := vol.Import(r.Body); err != nil {
+		utils.InternalServerError(w, err)
+		return
+	}
+	utils.WriteResponse(w, http.StatusNoContent, "")
+}
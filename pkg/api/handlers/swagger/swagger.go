@@ -63,6 +63,13 @@ type swagLibpodPlayKubeResponse struct {
 	Body entities.PlayKubeReport
 }
 
+// PlayKubeDown response
+// swagger:response DocsLibpodPlayKubeDownResponse
+type swagLibpodPlayKubeDownResponse struct {
+	// in:body
+	Body entities.PlayKubeDownReport
+}
+
 // Delete response
 // swagger:response DocsImageDeleteResponse
 type swagImageDeleteResponse struct {
@@ -178,3 +185,31 @@ type swagImageTreeResponse struct {
 		handlers.ImageTreeResponse
 	}
 }
+
+// Auto-update response
+// swagger:response DocsLibpodAutoUpdateResponse
+type swagLibpodAutoUpdateResponse struct {
+	// in:body
+	Body entities.AutoUpdateResponse
+}
+
+// Runlabel response, streamed
+// swagger:response DocsLibpodRunlabelResponse
+type swagLibpodRunlabelResponse struct {
+	// in:body
+	Body entities.ContainerRunlabelReport
+}
+
+// Show trust response
+// swagger:response DocsLibpodShowTrustResponse
+type swagLibpodShowTrustResponse struct {
+	// in:body
+	Body entities.ShowTrustReport
+}
+
+// Sign response
+// swagger:response DocsLibpodSignResponse
+type swagLibpodSignResponse struct {
+	// in:body
+	Body entities.SignReport
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/containers/podman/v2/libpod/define"
 	"github.com/containers/podman/v2/pkg/domain/entities"
+	"github.com/containers/podman/v2/pkg/secrets"
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 )
@@ -72,6 +73,15 @@ func PodNotFound(w http.ResponseWriter, name string, err error) {
 	Error(w, msg, http.StatusNotFound, err)
 }
 
+func SecretNotFound(w http.ResponseWriter, nameOrID string, err error) {
+	if errors.Cause(err) != secrets.ErrNoSuchSecret {
+		InternalServerError(w, err)
+		return
+	}
+	msg := fmt.Sprintf("No such secret: %s", nameOrID)
+	Error(w, msg, http.StatusNotFound, err)
+}
+
 func SessionNotFound(w http.ResponseWriter, name string, err error) {
 	if errors.Cause(err) != define.ErrNoSuchExecSession {
 		InternalServerError(w, err)
@@ -4,7 +4,6 @@ import (
 	"net/http"
 
 	"github.com/containers/podman/v2/pkg/api/handlers/compat"
-	"github.com/containers/podman/v2/pkg/api/handlers/libpod"
 	"github.com/gorilla/mux"
 )
 
@@ -35,7 +34,7 @@ func (s *APIServer) registerAchiveHandlers(r *mux.Router) error {
 	//   - in: query
 	//     name: copyUIDGID
 	//     type: string
-	//     description: copy UID/GID maps to the dest file or di (1 or true)
+	//     description: set the owner of the extracted files to the user of the container (1 or true)
 	//   - in: body
 	//     name: request
 	//     description: tarfile of files to copy into the container
@@ -84,6 +83,35 @@ func (s *APIServer) registerAchiveHandlers(r *mux.Router) error {
 	//      $ref: "#/responses/NoSuchContainer"
 	//    500:
 	//      $ref: "#/responses/InternalError"
+
+	// swagger:operation HEAD /containers/{name}/archive compat headArchive
+	// ---
+	//  summary: Get information about files in a container
+	//  description: |
+	//    The response header X-Docker-Container-Path-Stat holds the base64
+	//    encoded JSON stat of the path. Symlinks are followed.
+	//  tags:
+	//   - containers (compat)
+	//  parameters:
+	//   - in: path
+	//     name: name
+	//     type: string
+	//     description: container name or id
+	//     required: true
+	//   - in: query
+	//     name: path
+	//     type: string
+	//     description: Path in the container to stat
+	//     required: true
+	//  responses:
+	//    200:
+	//      description: no error
+	//    400:
+	//      $ref: "#/responses/BadParamError"
+	//    404:
+	//      $ref: "#/responses/NoSuchContainer"
+	//    500:
+	//      $ref: "#/responses/InternalError"
 	r.HandleFunc(VersionedPath("/containers/{name}/archive"), s.APIHandler(compat.Archive)).Methods(http.MethodGet, http.MethodPut, http.MethodHead)
 	// Added non version path to URI to support docker non versioned paths
 	r.HandleFunc("/containers/{name}/archive", s.APIHandler(compat.Archive)).Methods(http.MethodGet, http.MethodPut, http.MethodHead)
@@ -164,8 +192,8 @@ func (s *APIServer) registerAchiveHandlers(r *mux.Router) error {
 	//      $ref: "#/responses/NoSuchContainer"
 	//    500:
 	//      $ref: "#/responses/InternalError"
-	r.HandleFunc(VersionedPath("/libpod/containers/{name}/copy"), s.APIHandler(libpod.Archive)).Methods(http.MethodGet, http.MethodPost)
-	r.HandleFunc(VersionedPath("/libpod/containers/{name}/archive"), s.APIHandler(libpod.Archive)).Methods(http.MethodGet, http.MethodPost)
+	r.HandleFunc(VersionedPath("/libpod/containers/{name}/copy"), s.APIHandler(compat.Archive)).Methods(http.MethodGet, http.MethodPost)
+	r.HandleFunc(VersionedPath("/libpod/containers/{name}/archive"), s.APIHandler(compat.Archive)).Methods(http.MethodGet, http.MethodPut, http.MethodPost, http.MethodHead)
 
 	return nil
 }
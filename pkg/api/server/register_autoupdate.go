@@ -0,0 +1,41 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/containers/podman/v2/pkg/api/handlers/libpod"
+	"github.com/gorilla/mux"
+)
+
+func (s *APIServer) registerAutoUpdateHandlers(r *mux.Router) error {
+	// swagger:operation POST /libpod/auto-update libpod libpodAutoUpdate
+	// ---
+	// tags:
+	//  - containers
+	// summary: Auto update containers
+	// description: |
+	//   Update containers according to their auto-update policy and restart their systemd units.
+	//   Registry credentials can be passed in the X-Registry-Auth header.
+	// parameters:
+	//  - in: query
+	//    name: rollback
+	//    type: boolean
+	//    default: false
+	//    description: roll back to the previous image if the update fails
+	//  - in: query
+	//    name: dryRun
+	//    type: boolean
+	//    default: false
+	//    description: check for pending updates without pulling images or restarting systemd units
+	// produces:
+	// - application/json
+	// responses:
+	//   200:
+	//     $ref: "#/responses/DocsLibpodAutoUpdateResponse"
+	//   400:
+	//     $ref: "#/responses/BadParamError"
+	//   500:
+	//     $ref: "#/responses/InternalError"
+	r.HandleFunc(VersionedPath("/libpod/auto-update"), s.APIHandler(libpod.AutoUpdate)).Methods(http.MethodPost)
+	return nil
+}
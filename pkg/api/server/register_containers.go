@@ -291,9 +291,37 @@ func (s *APIServer) registerContainersHandlers(r *mux.Router) error {
 	r.HandleFunc(VersionedPath("/containers/{name}/pause"), s.APIHandler(compat.PauseContainer)).Methods(http.MethodPost)
 	// Added non version path to URI to support docker non versioned paths
 	r.HandleFunc("/containers/{name}/pause", s.APIHandler(compat.PauseContainer)).Methods(http.MethodPost)
-	r.HandleFunc(VersionedPath("/containers/{name}/rename"), s.APIHandler(compat.UnsupportedHandler)).Methods(http.MethodPost)
+	// swagger:operation POST /containers/{name}/rename compat renameContainer
+	// ---
+	// tags:
+	//   - containers (compat)
+	// summary: Rename an existing container
+	// description: Change the name of an existing container.
+	// parameters:
+	//  - in: path
+	//    name: name
+	//    type: string
+	//    required: true
+	//    description: Full or partial ID or full name of the container to rename
+	//  - in: query
+	//    name: name
+	//    type: string
+	//    required: true
+	//    description: New name for the container
+	// produces:
+	// - application/json
+	// responses:
+	//   204:
+	//     description: no error
+	//   404:
+	//     $ref: "#/responses/NoSuchContainer"
+	//   409:
+	//     $ref: "#/responses/ConflictError"
+	//   500:
+	//     $ref: "#/responses/InternalError"
+	r.HandleFunc(VersionedPath("/containers/{name}/rename"), s.APIHandler(compat.RenameContainer)).Methods(http.MethodPost)
 	// Added non version path to URI to support docker non versioned paths
-	r.HandleFunc("/containers/{name}/rename", s.APIHandler(compat.UnsupportedHandler)).Methods(http.MethodPost)
+	r.HandleFunc("/containers/{name}/rename", s.APIHandler(compat.RenameContainer)).Methods(http.MethodPost)
 	// swagger:operation POST /containers/{name}/restart compat restartContainer
 	// ---
 	// tags:
@@ -461,6 +489,67 @@ func (s *APIServer) registerContainersHandlers(r *mux.Router) error {
 	r.HandleFunc(VersionedPath("/containers/{name}/unpause"), s.APIHandler(compat.UnpauseContainer)).Methods(http.MethodPost)
 	// Added non version path to URI to support docker non versioned paths
 	r.HandleFunc("/containers/{name}/unpause", s.APIHandler(compat.UnpauseContainer)).Methods(http.MethodPost)
+	// swagger:operation POST /containers/{name}/update compat updateContainer
+	// ---
+	// tags:
+	//   - containers (compat)
+	// summary: Update a container
+	// description: Change the resource limits of a container. Only the limits set in the request are changed.
+	// parameters:
+	//  - in: path
+	//    name: name
+	//    type: string
+	//    required: true
+	//    description: the name or ID of the container
+	//  - in: body
+	//    name: update
+	//    description: The resource limits to update
+	//    schema:
+	//      type: object
+	//      properties:
+	//        CpuShares:
+	//          type: integer
+	//        CpuPeriod:
+	//          type: integer
+	//        CpuQuota:
+	//          type: integer
+	//        CpusetCpus:
+	//          type: string
+	//        CpusetMems:
+	//          type: string
+	//        Memory:
+	//          type: integer
+	//        MemoryReservation:
+	//          type: integer
+	//        MemorySwap:
+	//          type: integer
+	//        PidsLimit:
+	//          type: integer
+	//        BlkioWeight:
+	//          type: integer
+	// produces:
+	// - application/json
+	// responses:
+	//   200:
+	//     description: no error
+	//     schema:
+	//       type: object
+	//       properties:
+	//         Warnings:
+	//           type: array
+	//           items:
+	//             type: string
+	//   400:
+	//     $ref: "#/responses/BadParamError"
+	//   404:
+	//     $ref: "#/responses/NoSuchContainer"
+	//   409:
+	//     $ref: "#/responses/ConflictError"
+	//   500:
+	//     $ref: "#/responses/InternalError"
+	r.HandleFunc(VersionedPath("/containers/{name}/update"), s.APIHandler(compat.UpdateContainer)).Methods(http.MethodPost)
+	// Added non version path to URI to support docker non versioned paths
+	r.HandleFunc("/containers/{name}/update", s.APIHandler(compat.UpdateContainer)).Methods(http.MethodPost)
 	// swagger:operation POST /containers/{name}/wait compat waitContainer
 	// ---
 	// tags:
@@ -933,6 +1022,35 @@ func (s *APIServer) registerContainersHandlers(r *mux.Router) error {
 	//   500:
 	//      $ref: "#/responses/InternalError"
 	r.HandleFunc(VersionedPath("/libpod/containers/{name}/logs"), s.APIHandler(compat.LogsFromContainer)).Methods(http.MethodGet)
+	// swagger:operation POST /libpod/containers/{name}/rename libpod libpodRenameContainer
+	// ---
+	// tags:
+	//   - containers
+	// summary: Rename an existing container
+	// description: Change the name of an existing container.
+	// parameters:
+	//  - in: path
+	//    name: name
+	//    type: string
+	//    required: true
+	//    description: Full or partial ID or full name of the container to rename
+	//  - in: query
+	//    name: name
+	//    type: string
+	//    required: true
+	//    description: New name for the container
+	// produces:
+	// - application/json
+	// responses:
+	//   204:
+	//     description: no error
+	//   404:
+	//     $ref: "#/responses/NoSuchContainer"
+	//   409:
+	//     $ref: "#/responses/ConflictError"
+	//   500:
+	//     $ref: "#/responses/InternalError"
+	r.HandleFunc(VersionedPath("/libpod/containers/{name}/rename"), s.APIHandler(compat.RenameContainer)).Methods(http.MethodPost)
 	// swagger:operation POST /libpod/containers/{name}/pause libpod libpodPauseContainer
 	// ---
 	// tags:
@@ -1119,6 +1237,37 @@ func (s *APIServer) registerContainersHandlers(r *mux.Router) error {
 	//   500:
 	//     $ref: "#/responses/InternalError"
 	r.HandleFunc(VersionedPath("/libpod/containers/{name}/unpause"), s.APIHandler(compat.UnpauseContainer)).Methods(http.MethodPost)
+	// swagger:operation POST /libpod/containers/{name}/update libpod libpodUpdateContainer
+	// ---
+	// tags:
+	//  - containers
+	// summary: Update a container
+	// description: Change the resource limits of a container. Only the limits set in the request are changed.
+	// parameters:
+	//  - in: path
+	//    name: name
+	//    type: string
+	//    required: true
+	//    description: the name or ID of the container
+	//  - in: body
+	//    name: resources
+	//    description: The OCI resource limits to update
+	//    schema:
+	//      type: object
+	// produces:
+	// - application/json
+	// responses:
+	//   204:
+	//     description: no error
+	//   400:
+	//     $ref: "#/responses/BadParamError"
+	//   404:
+	//     $ref: "#/responses/NoSuchContainer"
+	//   409:
+	//     $ref: "#/responses/ConflictError"
+	//   500:
+	//     $ref: "#/responses/InternalError"
+	r.HandleFunc(VersionedPath("/libpod/containers/{name}/update"), s.APIHandler(libpod.UpdateContainer)).Methods(http.MethodPost)
 	// swagger:operation POST /libpod/containers/{name}/wait libpod libpodWaitContainer
 	// ---
 	// tags:
@@ -1341,6 +1490,18 @@ func (s *APIServer) registerContainersHandlers(r *mux.Router) error {
 	//    name: ignoreRootFS
 	//    type: boolean
 	//    description: do not include root file-system changes when exporting
+	//  - in: query
+	//    name: preCheckpoint
+	//    type: boolean
+	//    description: only dump the memory pages of the container, which keeps running
+	//  - in: query
+	//    name: withPrevious
+	//    type: boolean
+	//    description: only dump the memory pages changed since the last pre-checkpoint
+	//  - in: query
+	//    name: createImage
+	//    type: string
+	//    description: commit the checkpoint to an image with this name
 	// produces:
 	// - application/json
 	// responses:
@@ -1356,7 +1517,7 @@ func (s *APIServer) registerContainersHandlers(r *mux.Router) error {
 	// tags:
 	//   - containers
 	// summary: Restore a container
-	// description: Restore a container from a checkpoint.
+	// description: Restore a container from a checkpoint. If no container with the name or ID exists, the container is restored from the checkpoint image with that name, which is pulled if it does not exist locally.
 	// parameters:
 	//  - in: path
 	//    name: name
@@ -1462,5 +1623,73 @@ func (s *APIServer) registerContainersHandlers(r *mux.Router) error {
 	//   500:
 	//     $ref: "#/responses/InternalError"
 	r.HandleFunc(VersionedPath("/libpod/containers/{name}/init"), s.APIHandler(libpod.InitContainer)).Methods(http.MethodPost)
+	// swagger:operation POST /libpod/containers/runlabel libpod libpodRunlabel
+	// ---
+	// tags:
+	//  - containers
+	// summary: Execute the command of an image label
+	// description: |
+	//   Execute the command described by a label of an image on the server and stream its output
+	//   as JSON reports, the last of which holds the error if the command failed after writing
+	//   output. Commands running interactive containers are rejected, as stdin is not attached.
+	//   Registry credentials for pulling the image can be passed in the X-Registry-Auth header.
+	// parameters:
+	//  - in: query
+	//    name: image
+	//    type: string
+	//    required: true
+	//    description: the name or ID of the image
+	//  - in: query
+	//    name: label
+	//    type: string
+	//    required: true
+	//    description: the name of the label holding the command
+	//  - in: query
+	//    name: args
+	//    type: array
+	//    items:
+	//       type: string
+	//    description: arguments appended to the command
+	//  - in: query
+	//    name: display
+	//    type: boolean
+	//    default: false
+	//    description: only return the command without executing it
+	//  - in: query
+	//    name: name
+	//    type: string
+	//    description: name of the container created by the command
+	//  - in: query
+	//    name: replace
+	//    type: boolean
+	//    default: false
+	//    description: replace an existing container with the same name
+	//  - in: query
+	//    name: pull
+	//    type: boolean
+	//    default: true
+	//    description: pull the image if it does not exist locally
+	//  - in: query
+	//    name: quiet
+	//    type: boolean
+	//    default: false
+	//    description: suppress the output of the command
+	//  - in: query
+	//    name: tlsVerify
+	//    type: boolean
+	//    default: true
+	//    description: require HTTPS and verify signatures when contacting registries
+	// produces:
+	// - application/json
+	// responses:
+	//   200:
+	//     $ref: "#/responses/DocsLibpodRunlabelResponse"
+	//   400:
+	//     $ref: "#/responses/BadParamError"
+	//   404:
+	//     $ref: "#/responses/NoSuchImage"
+	//   500:
+	//     $ref: "#/responses/InternalError"
+	r.HandleFunc(VersionedPath("/libpod/containers/runlabel"), s.APIHandler(libpod.Runlabel)).Methods(http.MethodPost)
 	return nil
 }
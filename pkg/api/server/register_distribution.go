@@ -1,13 +1,75 @@
 package server
 
 import (
+	"net/http"
+
 	"github.com/containers/podman/v2/pkg/api/handlers/compat"
 	"github.com/gorilla/mux"
 )
 
 func (s *APIServer) registerDistributionHandlers(r *mux.Router) error {
-	r.HandleFunc(VersionedPath("/distribution/{name}/json"), compat.UnsupportedHandler)
+	// swagger:operation GET /distribution/{name}/json compat DistributionInspect
+	// ---
+	// tags:
+	//  - images (compat)
+	// summary: Inspect an image in a registry
+	// description: Return the descriptor of the manifest and the platforms of an image in a registry without pulling it.
+	// parameters:
+	//  - in: path
+	//    name: name
+	//    type: string
+	//    required: true
+	//    description: the name of the image in the registry
+	//  - in: header
+	//    name: X-Registry-Auth
+	//    type: string
+	//    description: "base64-encoded auth configuration for the registry"
+	// produces:
+	// - application/json
+	// responses:
+	//   200:
+	//     $ref: "#/responses/DistributionInspectResponse"
+	//   401:
+	//     description: authentication to the registry failed
+	//   404:
+	//     $ref: "#/responses/NoSuchImage"
+	//   500:
+	//     $ref: "#/responses/InternalError"
+	r.Handle(VersionedPath("/distribution/{name:.*}/json"), s.APIHandler(compat.DistributionInspect)).Methods(http.MethodGet)
 	// Added non version path to URI to support docker non versioned paths
-	r.HandleFunc("/distribution/{name}/json", compat.UnsupportedHandler)
+	r.Handle("/distribution/{name:.*}/json", s.APIHandler(compat.DistributionInspect)).Methods(http.MethodGet)
+	// swagger:operation GET /libpod/distribution/{name}/json libpod DistributionInspectLibpod
+	// ---
+	// tags:
+	//  - images
+	// summary: Inspect an image in a registry
+	// description: Return the name, the descriptor of the manifest and the platforms of an image in a registry without pulling it.
+	// parameters:
+	//  - in: path
+	//    name: name
+	//    type: string
+	//    required: true
+	//    description: the name of the image in the registry
+	//  - in: query
+	//    name: tlsVerify
+	//    type: boolean
+	//    default: true
+	//    description: Require TLS verification.
+	//  - in: header
+	//    name: X-Registry-Auth
+	//    type: string
+	//    description: "base64-encoded auth configuration for the registry"
+	// produces:
+	// - application/json
+	// responses:
+	//   200:
+	//     $ref: "#/responses/RegistryInspectReport"
+	//   401:
+	//     description: authentication to the registry failed
+	//   404:
+	//     $ref: "#/responses/NoSuchImage"
+	//   500:
+	//     $ref: "#/responses/InternalError"
+	r.Handle(VersionedPath("/libpod/distribution/{name:.*}/json"), s.APIHandler(compat.DistributionInspect)).Methods(http.MethodGet)
 	return nil
 }
@@ -1480,5 +1480,109 @@ func (s *APIServer) registerImagesHandlers(r *mux.Router) error {
 	//   500:
 	//     $ref: "#/responses/InternalError"
 	r.Handle(VersionedPath("/libpod/build"), s.APIHandler(compat.BuildImage)).Methods(http.MethodPost)
+	// swagger:operation GET /libpod/images/trust libpod libpodShowTrust
+	// ---
+	// tags:
+	//  - images
+	// summary: Show trust policy
+	// description: Show the image trust policy of the server.
+	// parameters:
+	//  - in: query
+	//    name: raw
+	//    type: boolean
+	//    default: false
+	//    description: only return the raw policy file
+	//  - in: query
+	//    name: policyPath
+	//    type: string
+	//    description: path of the policy file on the server
+	//  - in: query
+	//    name: registryPath
+	//    type: string
+	//    description: path of the registries.d directory on the server
+	// produces:
+	// - application/json
+	// responses:
+	//   200:
+	//     $ref: "#/responses/DocsLibpodShowTrustResponse"
+	//   400:
+	//     $ref: "#/responses/BadParamError"
+	//   500:
+	//     $ref: "#/responses/InternalError"
+	r.Handle(VersionedPath("/libpod/images/trust"), s.APIHandler(libpod.ShowTrust)).Methods(http.MethodGet)
+	// swagger:operation POST /libpod/images/trust libpod libpodSetTrust
+	// ---
+	// tags:
+	//  - images
+	// summary: Set trust policy
+	// description: Set the default trust policy or the trust policy of a registry on the server.
+	// parameters:
+	//  - in: query
+	//    name: scope
+	//    type: string
+	//    required: true
+	//    description: the registry to set the policy for, or "default"
+	//  - in: query
+	//    name: type
+	//    type: string
+	//    default: signedBy
+	//    description: "trust type: accept, reject or signedBy"
+	//  - in: query
+	//    name: pubKeysFile
+	//    type: array
+	//    items:
+	//       type: string
+	//    description: paths of public keys on the server to trust for the scope
+	//  - in: query
+	//    name: policyPath
+	//    type: string
+	//    description: path of the policy file on the server
+	// produces:
+	// - application/json
+	// responses:
+	//   204:
+	//     description: no error
+	//   400:
+	//     $ref: "#/responses/BadParamError"
+	//   500:
+	//     $ref: "#/responses/InternalError"
+	r.Handle(VersionedPath("/libpod/images/trust"), s.APIHandler(libpod.SetTrust)).Methods(http.MethodPost)
+	// swagger:operation POST /libpod/images/sign libpod libpodSignImages
+	// ---
+	// tags:
+	//  - images
+	// summary: Sign images
+	// description: Sign images with a GPG key of the server and store the signatures on the server.
+	// parameters:
+	//  - in: query
+	//    name: images
+	//    type: array
+	//    items:
+	//       type: string
+	//    required: true
+	//    description: the images to sign, including their transport (e.g. docker://)
+	//  - in: query
+	//    name: signBy
+	//    type: string
+	//    required: true
+	//    description: name of the signing key
+	//  - in: query
+	//    name: directory
+	//    type: string
+	//    description: alternate directory on the server to store the signatures in
+	//  - in: query
+	//    name: certDir
+	//    type: string
+	//    description: path of a directory on the server containing TLS certificates and keys
+	// produces:
+	// - application/json
+	// responses:
+	//   200:
+	//     $ref: "#/responses/DocsLibpodSignResponse"
+	//   400:
+	//     $ref: "#/responses/BadParamError"
+	//   500:
+	//     $ref: "#/responses/InternalError"
+	r.Handle(VersionedPath("/libpod/images/sign"), s.APIHandler(libpod.SignImages)).Methods(http.MethodPost)
 	return nil
 }
@@ -38,6 +38,26 @@ func (s *APIServer) registerManifestHandlers(r *mux.Router) error {
 	//   500:
 	//     $ref: "#/responses/InternalError"
 	r.Handle(VersionedPath("/libpod/manifests/create"), s.APIHandler(libpod.ManifestCreate)).Methods(http.MethodPost)
+	// swagger:operation GET /libpod/manifests/{name:.*}/exists manifests ExistsManifest
+	// ---
+	// summary: Exists
+	// description: Check if a manifest list exists in local storage
+	// produces:
+	// - application/json
+	// parameters:
+	//  - in: path
+	//    name: name:.*
+	//    type: string
+	//    required: true
+	//    description: the name or ID of the manifest
+	// responses:
+	//   204:
+	//     description: manifest list exists
+	//   404:
+	//     $ref: "#/responses/NoSuchManifest"
+	//   500:
+	//     $ref: "#/responses/InternalError"
+	r.Handle(VersionedPath("/libpod/manifests/{name:.*}/exists"), s.APIHandler(libpod.ManifestExists)).Methods(http.MethodGet)
 	// swagger:operation GET /libpod/manifests/{name:.*}/json manifests Inspect
 	// ---
 	// summary: Inspect
@@ -84,6 +104,38 @@ func (s *APIServer) registerManifestHandlers(r *mux.Router) error {
 	//   500:
 	//     $ref: "#/responses/InternalError"
 	r.Handle(VersionedPath("/libpod/manifests/{name:.*}/add"), s.APIHandler(libpod.ManifestAdd)).Methods(http.MethodPost)
+	// swagger:operation POST /libpod/manifests/{name:.*}/annotate manifests AnnotateManifest
+	// ---
+	// summary: Annotate
+	// description: Update the image configuration of an image in a manifest list
+	// produces:
+	// - application/json
+	// parameters:
+	//  - in: path
+	//    name: name:.*
+	//    type: string
+	//    required: true
+	//    description: the name or ID of the manifest
+	//  - in: query
+	//    name: digest
+	//    type: string
+	//    required: true
+	//    description: digest of the image to be annotated
+	//  - in: body
+	//    name: options
+	//    description: the image configuration to be set
+	//    schema:
+	//      $ref: "#/definitions/ManifestAnnotateOpts"
+	// responses:
+	//   200:
+	//     $ref: "#/definitions/IDResponse"
+	//   400:
+	//     $ref: "#/responses/BadParamError"
+	//   404:
+	//     $ref: "#/responses/NoSuchManifest"
+	//   500:
+	//     $ref: "#/responses/InternalError"
+	r.Handle(VersionedPath("/libpod/manifests/{name:.*}/annotate"), s.APIHandler(libpod.ManifestAnnotate)).Methods(http.MethodPost)
 	// swagger:operation DELETE /libpod/manifests/{name:.*} manifests RemoveManifest
 	// ---
 	// summary: Remove
@@ -47,5 +47,26 @@ func (s *APIServer) registerPlayHandlers(r *mux.Router) error {
 	//   500:
 	//     $ref: "#/responses/InternalError"
 	r.HandleFunc(VersionedPath("/libpod/play/kube"), s.APIHandler(libpod.PlayKube)).Methods(http.MethodPost)
+	// swagger:operation DELETE /libpod/play/kube libpod libpodPlayKubeDown
+	// ---
+	// tags:
+	//  - containers
+	//  - pods
+	// summary: Remove pods from play kube
+	// description: Tears down pods and volumes defined in a Kubernetes YAML file, as created by play kube.
+	// parameters:
+	//  - in: body
+	//    name: request
+	//    description: Kubernetes YAML file.
+	//    schema:
+	//      type: string
+	// produces:
+	// - application/json
+	// responses:
+	//   200:
+	//     $ref: "#/responses/DocsLibpodPlayKubeDownResponse"
+	//   500:
+	//     $ref: "#/responses/InternalError"
+	r.HandleFunc(VersionedPath("/libpod/play/kube"), s.APIHandler(libpod.PlayKubeDown)).Methods(http.MethodDelete)
 	return nil
 }
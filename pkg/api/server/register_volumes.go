@@ -113,6 +113,62 @@ func (s *APIServer) registerVolumeHandlers(r *mux.Router) error {
 	//   500:
 	//     $ref: "#/responses/InternalError"
 	r.Handle(VersionedPath("/libpod/volumes/{name}"), s.APIHandler(libpod.RemoveVolume)).Methods(http.MethodDelete)
+	// swagger:operation GET /libpod/volumes/{name}/export libpod libpodExportVolume
+	// ---
+	// tags:
+	//  - volumes
+	// summary: Export a volume
+	// description: |
+	//   Export the contents of a volume as an uncompressed tar archive.
+	//   As rootless, the owners of the files in the archive are the UIDs and GIDs seen by containers.
+	// parameters:
+	//  - in: path
+	//    name: name
+	//    type: string
+	//    required: true
+	//    description: the name or ID of the volume
+	// produces:
+	// - application/x-tar
+	// responses:
+	//   200:
+	//     description: tar archive of the volume contents
+	//   404:
+	//     $ref: "#/responses/NoSuchVolume"
+	//   500:
+	//     $ref: "#/responses/InternalError"
+	r.Handle(VersionedPath("/libpod/volumes/{name}/export"), s.APIHandler(libpod.ExportVolume)).Methods(http.MethodGet)
+	// swagger:operation POST /libpod/volumes/{name}/import libpod libpodImportVolume
+	// ---
+	// tags:
+	//  - volumes
+	// summary: Import into a volume
+	// description: |
+	//   Extract a tar archive into a volume. The archive may be compressed.
+	//   Files in the volume are replaced by the files of the archive with the same path, all other files are kept.
+	// consumes:
+	// - application/x-tar
+	// parameters:
+	//  - in: path
+	//    name: name
+	//    type: string
+	//    required: true
+	//    description: the name or ID of the volume
+	//  - in: body
+	//    name: request
+	//    description: tar archive to extract into the volume
+	//    schema:
+	//      type: string
+	//      format: binary
+	// produces:
+	// - application/json
+	// responses:
+	//   204:
+	//     description: no error
+	//   404:
+	//     $ref: "#/responses/NoSuchVolume"
+	//   500:
+	//     $ref: "#/responses/InternalError"
+	r.Handle(VersionedPath("/libpod/volumes/{name}/import"), s.APIHandler(libpod.ImportVolume)).Methods(http.MethodPost)
 
 	/*
 	 * Docker compatibility endpoints
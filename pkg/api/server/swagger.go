@@ -42,6 +42,15 @@ type swagErrNoSuchExecInstance struct {
 	}
 }
 
+// No such secret
+// swagger:response NoSuchSecret
+type swagErrNoSuchSecret struct {
+	// in:body
+	Body struct {
+		entities.ErrorModel
+	}
+}
+
 // No such volume
 // swagger:response NoSuchVolume
 type swagErrNoSuchVolume struct {
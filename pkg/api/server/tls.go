@@ -0,0 +1,51 @@
+package server
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+	"net"
+
+	"github.com/pkg/errors"
+)
+
+// TLSOptions are the options for serving the API over TLS.
+type TLSOptions struct {
+	// CertFile - path of the PEM encoded certificate of the server.
+	CertFile string
+	// KeyFile - path of the PEM encoded private key of the server.
+	KeyFile string
+	// ClientCAFile - path of the PEM encoded CA certificates. If set,
+	// clients must present a certificate signed by one of them (mutual TLS).
+	ClientCAFile string
+}
+
+// NewTLSListener wraps listener so that it only accepts TLS connections
+// configured according to options.
+func NewTLSListener(listener net.Listener, options TLSOptions) (net.Listener, error) {
+	if options.CertFile == "" || options.KeyFile == "" {
+		return nil, errors.New("a certificate and a key are required for TLS")
+	}
+	cert, err := tls.LoadX509KeyPair(options.CertFile, options.KeyFile)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to load the TLS certificate and key")
+	}
+
+	config := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if options.ClientCAFile != "" {
+		pem, err := ioutil.ReadFile(options.ClientCAFile)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to read the client CA certificates")
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, errors.Errorf("no certificates found in %q", options.ClientCAFile)
+		}
+		config.ClientCAs = pool
+		config.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return tls.NewListener(listener, config), nil
+}
@@ -54,7 +54,7 @@ func getConfigCredentials(r *http.Request) (*types.DockerAuthConfig, string, err
 	configs := make(map[string]types.DockerAuthConfig)
 
 	for _, h := range r.Header[string(XRegistryConfigHeader)] {
-		param, err := base64.URLEncoding.DecodeString(h)
+		param, err := decodeHeader(h)
 		if err != nil {
 			return nil, "", errors.Wrapf(err, "failed to decode %q", XRegistryConfigHeader)
 		}
@@ -98,6 +98,10 @@ func getConfigCredentials(r *http.Request) (*types.DockerAuthConfig, string, err
 		}
 	}
 
+	// Without any credentials the auth file of the service is used.
+	if len(configs) == 0 {
+		return auth, "", nil
+	}
 	authfile, err := authConfigsToAuthFile(configs)
 	return auth, authfile, err
 }
@@ -111,6 +115,10 @@ func getAuthCredentials(r *http.Request) (*types.DockerAuthConfig, string, error
 	// First look for a multi-auth header (i.e., a map).
 	authConfigs, err := multiAuthHeader(r)
 	if err == nil {
+		// Without any credentials the auth file of the service is used.
+		if len(authConfigs) == 0 {
+			return nil, "", nil
+		}
 		authfile, err := authConfigsToAuthFile(authConfigs)
 		return nil, authfile, err
 	}
@@ -274,8 +282,17 @@ func authConfigsToAuthFile(authConfigs map[string]types.DockerAuthConfig) (strin
 // dockerAuthToImageAuth converts a docker auth config to one we're using
 // internally from c/image.  Note that the Docker types look slightly
 // different, so we need to convert to be extra sure we're not running into
-// undesired side-effects when unmarhalling directly to our types.
+// undesired side-effects when unmarhalling directly to our types.  Docker
+// clients may send the credentials as base64 encoded "username:password" in
+// the auth field instead of the username and password fields.
 func dockerAuthToImageAuth(authConfig dockerAPITypes.AuthConfig) types.DockerAuthConfig {
+	if authConfig.Username == "" && authConfig.Password == "" && authConfig.Auth != "" {
+		if decoded, err := base64.StdEncoding.DecodeString(authConfig.Auth); err == nil {
+			if split := strings.SplitN(string(decoded), ":", 2); len(split) == 2 {
+				authConfig.Username, authConfig.Password = split[0], split[1]
+			}
+		}
+	}
 	return types.DockerAuthConfig{
 		Username:      authConfig.Username,
 		Password:      authConfig.Password,
@@ -283,6 +300,12 @@ func dockerAuthToImageAuth(authConfig dockerAPITypes.AuthConfig) types.DockerAut
 	}
 }
 
+// DockerAuthToImageAuth converts the credentials sent by a Docker client, e.g.
+// in the body of a request to /auth, to the type used by c/image.
+func DockerAuthToImageAuth(authConfig dockerAPITypes.AuthConfig) types.DockerAuthConfig {
+	return dockerAuthToImageAuth(authConfig)
+}
+
 // reverse conversion of `dockerAuthToImageAuth`.
 func imageAuthToDockerAuth(authConfig types.DockerAuthConfig) dockerAPITypes.AuthConfig {
 	return dockerAPITypes.AuthConfig{
@@ -298,13 +321,19 @@ func singleAuthHeader(r *http.Request) (map[string]types.DockerAuthConfig, error
 	authHeader := r.Header.Get(string(XRegistryAuthHeader))
 	authConfig := dockerAPITypes.AuthConfig{}
 	if len(authHeader) > 0 {
-		authJSON := base64.NewDecoder(base64.URLEncoding, strings.NewReader(authHeader))
-		if err := json.NewDecoder(authJSON).Decode(&authConfig); err != nil {
+		authJSON, err := decodeHeader(authHeader)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(authJSON, &authConfig); err != nil {
 			return nil, err
 		}
 	}
 	authConfigs := make(map[string]types.DockerAuthConfig)
-	authConfigs["0"] = dockerAuthToImageAuth(authConfig)
+	// Docker clients send an empty config if no credentials are known.
+	if conf := dockerAuthToImageAuth(authConfig); conf != (types.DockerAuthConfig{}) {
+		authConfigs["0"] = conf
+	}
 	return authConfigs, nil
 }
 
@@ -317,8 +346,11 @@ func multiAuthHeader(r *http.Request) (map[string]types.DockerAuthConfig, error)
 	}
 
 	dockerAuthConfigs := make(map[string]dockerAPITypes.AuthConfig)
-	authJSON := base64.NewDecoder(base64.URLEncoding, strings.NewReader(authHeader))
-	if err := json.NewDecoder(authJSON).Decode(&dockerAuthConfigs); err != nil {
+	authJSON, err := decodeHeader(authHeader)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(authJSON, &dockerAuthConfigs); err != nil {
 		return nil, err
 	}
 
@@ -329,3 +361,17 @@ func multiAuthHeader(r *http.Request) (map[string]types.DockerAuthConfig, error)
 	}
 	return authConfigs, nil
 }
+
+// decodeHeader decodes the base64 encoded content of an authentication
+// header.  Docker clients use the URL-safe alphabet, but others send the
+// standard one, with or without padding.
+func decodeHeader(header string) ([]byte, error) {
+	var err error
+	for _, encoding := range []*base64.Encoding{base64.URLEncoding, base64.StdEncoding, base64.RawURLEncoding, base64.RawStdEncoding} {
+		var decoded []byte
+		if decoded, err = encoding.DecodeString(header); err == nil {
+			return decoded, nil
+		}
+	}
+	return nil, err
+}
@@ -0,0 +1,67 @@
+package auth
+
+import (
+	"encoding/base64"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/containers/image/v5/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetCredentialsSingleAuth(t *testing.T) {
+	expected := &types.DockerAuthConfig{Username: "foo", Password: "bar"}
+	for _, tc := range []struct {
+		name   string
+		header string
+	}{
+		{"URL encoding", base64.URLEncoding.EncodeToString([]byte(`{"username":"foo","password":"bar","serveraddress":"https://index.docker.io/v1/"}`))},
+		{"standard encoding without padding", base64.RawStdEncoding.EncodeToString([]byte(`{"username":"foo","password":"bar"}`))},
+		{"auth field", base64.URLEncoding.EncodeToString([]byte(`{"auth":"` + base64.StdEncoding.EncodeToString([]byte("foo:bar")) + `"}`))},
+	} {
+		r, err := http.NewRequest(http.MethodPost, "/images/create", nil)
+		require.NoError(t, err)
+		r.Header.Set(string(XRegistryAuthHeader), tc.header)
+
+		conf, authfile, key, err := GetCredentials(r)
+		require.NoError(t, err, tc.name)
+		assert.Equal(t, XRegistryAuthHeader, key, tc.name)
+		assert.Equal(t, "", authfile, tc.name)
+		assert.Equal(t, expected, conf, tc.name)
+	}
+}
+
+func TestGetCredentialsEmptyAuth(t *testing.T) {
+	// Docker clients send an empty config if they have no credentials,
+	// which must not override the auth file of the service.
+	for _, header := range []string{
+		base64.URLEncoding.EncodeToString([]byte(`{}`)),
+		base64.URLEncoding.EncodeToString([]byte(`{"serveraddress":"quay.io"}`)),
+	} {
+		r, err := http.NewRequest(http.MethodPost, "/images/create", nil)
+		require.NoError(t, err)
+		r.Header.Set(string(XRegistryAuthHeader), header)
+
+		conf, authfile, _, err := GetCredentials(r)
+		require.NoError(t, err)
+		assert.Nil(t, conf)
+		assert.Equal(t, "", authfile)
+	}
+}
+
+func TestGetCredentialsMultiAuth(t *testing.T) {
+	r, err := http.NewRequest(http.MethodPost, "/build", nil)
+	require.NoError(t, err)
+	r.Header.Set(string(XRegistryConfigHeader), base64.URLEncoding.EncodeToString([]byte(`{"https://index.docker.io/v1/":{"username":"foo","password":"bar"}}`)))
+
+	conf, authfile, key, err := GetCredentials(r)
+	require.NoError(t, err)
+	defer RemoveAuthfile(authfile)
+	assert.Equal(t, XRegistryConfigHeader, key)
+	assert.Nil(t, conf)
+	content, err := ioutil.ReadFile(authfile)
+	require.NoError(t, err)
+	assert.Contains(t, string(content), base64.StdEncoding.EncodeToString([]byte("foo:bar")))
+}
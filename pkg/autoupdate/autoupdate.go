@@ -4,6 +4,8 @@ import (
 	"context"
 	"os"
 	"sort"
+	"strings"
+	"time"
 
 	"github.com/containers/image/v5/docker"
 	"github.com/containers/image/v5/docker/reference"
@@ -12,9 +14,11 @@ import (
 	"github.com/containers/podman/v2/libpod"
 	"github.com/containers/podman/v2/libpod/define"
 	"github.com/containers/podman/v2/libpod/image"
+	"github.com/containers/podman/v2/pkg/domain/entities"
 	"github.com/containers/podman/v2/pkg/systemd"
 	systemdGen "github.com/containers/podman/v2/pkg/systemd/generate"
 	"github.com/containers/podman/v2/pkg/util"
+	"github.com/coreos/go-systemd/v22/dbus"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 )
@@ -35,6 +39,10 @@ const (
 	PolicyDefault Policy = "disabled"
 	// PolicyNewImage is the policy to update as soon as there's a new image found.
 	PolicyNewImage = "image"
+	// PolicyLocalImage is the policy to update as soon as the image name
+	// refers to a new image in the local storage, for instance after
+	// `podman build` or `podman load`.
+	PolicyLocalImage = "local"
 )
 
 // Map for easy lookups of supported policies.
@@ -42,8 +50,25 @@ var supportedPolicies = map[string]Policy{
 	"":         PolicyDefault,
 	"disabled": PolicyDefault,
 	"image":    PolicyNewImage,
+	"local":    PolicyLocalImage,
 }
 
+// The values of the Updated field of an entities.AutoUpdateReport.
+const (
+	// UpdatedTrue denotes a container that has been updated.
+	UpdatedTrue = "true"
+	// UpdatedFalse denotes a container that is up to date.
+	UpdatedFalse = "false"
+	// UpdatedPending denotes a container that would be updated, if it
+	// was not a dry run.
+	UpdatedPending = "pending"
+	// UpdatedFailed denotes a container whose update failed.
+	UpdatedFailed = "failed"
+	// UpdatedRolledBack denotes a container whose update failed and that
+	// has been rolled back to its previous image.
+	UpdatedRolledBack = "rolled back"
+)
+
 // LookupPolicy looksup the corresponding Policy for the specified
 // string. If none is found, an errors is returned including the list of
 // supported policies.
@@ -71,6 +96,28 @@ func LookupPolicy(s string) (Policy, error) {
 type Options struct {
 	// Authfile to use when contacting registries.
 	Authfile string
+	// Rollback to the previous image if the restarted systemd unit fails,
+	// or if the updated container does not become healthy.
+	Rollback bool
+	// DryRun only checks for updates, without pulling images or restarting
+	// systemd units.
+	DryRun bool
+}
+
+// update is a container whose image has been updated and whose systemd unit
+// must be restarted.
+type update struct {
+	// ctr is the container running the outdated image.
+	ctr *libpod.Container
+	// rawImageName is the image name the container has been created with.
+	rawImageName string
+	// previousImage is the image used before the update.
+	previousImage *image.Image
+	// candidate is the digest (PolicyNewImage) or ID (PolicyLocalImage) of
+	// the image the container is updated to.
+	candidate string
+	// report of the update.
+	report *entities.AutoUpdateReport
 }
 
 // ValidateImageReference checks if the specified imageName is a fully-qualified
@@ -102,11 +149,20 @@ func ValidateImageReference(imageName string) error {
 // accordingly.  If the policy is set to PolicyNewImage, it checks if the image
 // on the remote registry is different than the local one. If the image digests
 // differ, it pulls the remote image and restarts the systemd unit running the
-// container.
+// container.  If the policy is set to PolicyLocalImage, it checks if the image
+// name of the container now refers to a different image in the local storage
+// and restarts the systemd unit running the container if so.
 //
-// It returns a slice of successfully restarted systemd units and a slice of
-// errors encountered during auto update.
-func AutoUpdate(runtime *libpod.Runtime, options Options) ([]string, []error) {
+// If options.Rollback is set and a restarted unit fails, or its container does
+// not become healthy, the previous image is tagged again and the unit is
+// restarted once more.  The image is recorded, and later updates to the same
+// image are skipped.  If options.DryRun is set, no image is pulled and no
+// unit is restarted; the containers that would be updated are reported as
+// pending.
+//
+// It returns a report for each container with an auto-update policy and a
+// slice of errors encountered during auto update.
+func AutoUpdate(runtime *libpod.Runtime, options Options) ([]*entities.AutoUpdateReport, []error) {
 	// Create a map from `image ID -> []*Container`.
 	containerMap, errs := imageContainersMap(runtime)
 	if len(containerMap) == 0 {
@@ -123,16 +179,31 @@ func AutoUpdate(runtime *libpod.Runtime, options Options) ([]string, []error) {
 		imageMap[imagesSlice[i].ID()] = imagesSlice[i]
 	}
 
-	// Connect to DBUS.
-	conn, err := systemd.ConnectToDBUS()
+	// Look up the images updates have been rolled back from.
+	rolledBackFilePath, err := rolledBackPath(runtime)
 	if err != nil {
-		logrus.Errorf(err.Error())
 		return nil, []error{err}
 	}
-	defer conn.Close()
+	rolledBack, err := loadRolledBackImages(rolledBackFilePath)
+	if err != nil {
+		errs = append(errs, err)
+	}
+	rolledBackChanged := false
+
+	// Connect to DBUS.
+	var conn *dbus.Conn
+	if !options.DryRun {
+		conn, err = systemd.ConnectToDBUS()
+		if err != nil {
+			logrus.Errorf(err.Error())
+			return nil, []error{err}
+		}
+		defer conn.Close()
+	}
 
 	// Update images.
-	containersToRestart := []*libpod.Container{}
+	reports := []*entities.AutoUpdateReport{}
+	updates := []update{}
 	updatedRawImages := make(map[string]bool)
 	for imageID, containers := range containerMap {
 		image, exists := imageMap[imageID]
@@ -149,51 +220,215 @@ func AutoUpdate(runtime *libpod.Runtime, options Options) ([]string, []error) {
 				errs = append(errs, errors.Errorf("error auto-updating container %q: raw-image name is empty", ctr.ID()))
 			}
 			labels := ctr.Labels()
-			authFilePath, exists := labels[AuthfileLabel]
-			if exists {
-				options.Authfile = authFilePath
+			// The policy has been validated in imageContainersMap.
+			policy, _ := LookupPolicy(labels[Label])
+			report := &entities.AutoUpdateReport{
+				ContainerID:     ctr.ID(),
+				ContainerName:   ctr.Name(),
+				ImageName:       rawImageName,
+				Policy:          string(policy),
+				SystemdUnit:     labels[systemdGen.EnvVariable],
+				CurrentDigest:   image.Digest().String(),
+				CandidateDigest: image.Digest().String(),
+				Updated:         UpdatedFalse,
 			}
-			needsUpdate, err := newerImageAvailable(runtime, image, rawImageName, options)
-			if err != nil {
-				errs = append(errs, errors.Wrapf(err, "error auto-updating container %q: image check for %q failed", ctr.ID(), rawImageName))
-				continue
+			reports = append(reports, report)
+
+			var (
+				needsUpdate bool
+				candidate   string
+			)
+			switch policy {
+			case PolicyNewImage:
+				authFilePath, exists := labels[AuthfileLabel]
+				if exists {
+					options.Authfile = authFilePath
+				}
+				remoteDigest, err := remoteImageDigest(runtime, image, rawImageName, options)
+				if err != nil {
+					report.Updated = UpdatedFailed
+					errs = append(errs, errors.Wrapf(err, "error auto-updating container %q: image check for %q failed", ctr.ID(), rawImageName))
+					continue
+				}
+				report.CandidateDigest = remoteDigest
+				candidate = remoteDigest
+				needsUpdate = image.Digest().String() != remoteDigest
+			case PolicyLocalImage:
+				localImage, err := runtime.ImageRuntime().NewFromLocal(trimTransport(rawImageName))
+				if err != nil {
+					report.Updated = UpdatedFailed
+					errs = append(errs, errors.Wrapf(err, "error auto-updating container %q: image check for %q failed", ctr.ID(), rawImageName))
+					continue
+				}
+				report.CandidateDigest = localImage.Digest().String()
+				candidate = localImage.ID()
+				needsUpdate = localImage.ID() != image.ID()
 			}
 			if !needsUpdate {
 				continue
 			}
+			if rolledBack.skip(rawImageName, candidate) {
+				logrus.Infof("Skipping auto-update of container %q: the update to image %s of %q has been rolled back before", ctr.ID(), candidate, rawImageName)
+				continue
+			}
+			if options.DryRun {
+				report.Updated = UpdatedPending
+				continue
+			}
 			logrus.Infof("Auto-updating container %q using image %q", ctr.ID(), rawImageName)
-			if _, updated := updatedRawImages[rawImageName]; !updated {
-				_, err = updateImage(runtime, rawImageName, options)
+			if _, updated := updatedRawImages[rawImageName]; policy == PolicyNewImage && !updated {
+				_, err = updateImage(runtime, trimTransport(rawImageName), options)
 				if err != nil {
+					report.Updated = UpdatedFailed
 					errs = append(errs, errors.Wrapf(err, "error auto-updating container %q: image update for %q failed", ctr.ID(), rawImageName))
 					continue
 				}
 				updatedRawImages[rawImageName] = true
 			}
-			containersToRestart = append(containersToRestart, containers[i])
+			updates = append(updates, update{
+				ctr:           containers[i],
+				rawImageName:  rawImageName,
+				previousImage: image,
+				candidate:     candidate,
+				report:        report,
+			})
 		}
 	}
 
 	// Restart containers.
-	updatedUnits := []string{}
-	for _, ctr := range containersToRestart {
-		labels := ctr.Labels()
-		unit, exists := labels[systemdGen.EnvVariable]
-		if !exists {
+	for _, u := range updates {
+		ctr := u.ctr
+		unit := u.report.SystemdUnit
+		if unit == "" {
 			// Shouldn't happen but let's be sure of it.
+			u.report.Updated = UpdatedFailed
 			errs = append(errs, errors.Errorf("error auto-updating container %q: no %s label found", ctr.ID(), systemdGen.EnvVariable))
 			continue
 		}
-		_, err := conn.RestartUnit(unit, "replace", nil)
-		if err != nil {
+		err := restartSystemdUnit(conn, unit)
+		if err == nil && options.Rollback {
+			err = waitForHealthyUnit(runtime, unit)
+		}
+		if err == nil {
+			logrus.Infof("Successfully restarted systemd unit %q", unit)
+			ctr.NewAutoUpdateEvent(false)
+			u.report.Updated = UpdatedTrue
+			if _, exists := rolledBack[u.rawImageName]; exists {
+				delete(rolledBack, u.rawImageName)
+				rolledBackChanged = true
+			}
+			continue
+		}
+		u.report.Updated = UpdatedFailed
+		if !options.Rollback {
 			errs = append(errs, errors.Wrapf(err, "error auto-updating container %q: restarting systemd unit %q failed", ctr.ID(), unit))
 			continue
 		}
-		logrus.Infof("Successfully restarted systemd unit %q", unit)
-		updatedUnits = append(updatedUnits, unit)
+
+		logrus.Errorf("Auto-updating container %q failed, rolling back systemd unit %q: %v", ctr.ID(), unit, err)
+		if rollbackErr := rollback(conn, u, unit); rollbackErr != nil {
+			errs = append(errs, errors.Wrapf(rollbackErr, "error auto-updating container %q: rolling back systemd unit %q failed after error %v", ctr.ID(), unit, err))
+			continue
+		}
+		ctr.NewAutoUpdateEvent(true)
+		u.report.Updated = UpdatedRolledBack
+		rolledBack[u.rawImageName] = u.candidate
+		rolledBackChanged = true
+		errs = append(errs, errors.Wrapf(err, "error auto-updating container %q: systemd unit %q rolled back to the previous image", ctr.ID(), unit))
+	}
+
+	if rolledBackChanged {
+		if err := rolledBack.save(rolledBackFilePath); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return reports, errs
+}
+
+// restartSystemdUnit restarts the systemd unit and waits for the restart job
+// to finish.
+func restartSystemdUnit(conn *dbus.Conn, unit string) error {
+	restartChan := make(chan string)
+	if _, err := conn.RestartUnit(unit, "replace", restartChan); err != nil {
+		return err
+	}
+
+	// The result is one of done, canceled, timeout, failed, dependency
+	// or skipped.
+	if result := <-restartChan; result != "done" {
+		return errors.Errorf("restarting systemd unit %q finished with result %q", unit, result)
+	}
+	return nil
+}
+
+// waitForHealthyUnit waits for the container run by the systemd unit to
+// become healthy. The deadline is derived from the healthcheck of the
+// container: it is the time after which the container would be considered
+// unhealthy if every check failed. Containers without a healthcheck are
+// considered healthy as soon as they run.
+func waitForHealthyUnit(runtime *libpod.Runtime, unit string) error {
+	ctrs, err := runtime.GetContainers(func(c *libpod.Container) bool {
+		return c.Labels()[systemdGen.EnvVariable] == unit
+	})
+	if err != nil {
+		return err
+	}
+
+	var ctr *libpod.Container
+	for _, c := range ctrs {
+		state, err := c.State()
+		if err != nil {
+			return err
+		}
+		if state == define.ContainerStateRunning {
+			ctr = c
+			break
+		}
+	}
+	if ctr == nil {
+		return errors.Errorf("no running container found for systemd unit %q", unit)
 	}
 
-	return updatedUnits, errs
+	config := ctr.HealthCheckConfig()
+	if !ctr.HasHealthCheck() || config == nil || config.Interval == 0 {
+		return nil
+	}
+
+	retries := time.Duration(config.Retries)
+	deadline := time.Now().Add(config.StartPeriod + (config.Interval+config.Timeout)*(retries+1))
+	for {
+		status, err := ctr.HealthCheckStatus()
+		if err != nil {
+			return err
+		}
+		switch status {
+		case define.HealthCheckHealthy:
+			return nil
+		case define.HealthCheckUnhealthy:
+			return errors.Errorf("container %q is unhealthy", ctr.ID())
+		}
+		if time.Now().After(deadline) {
+			return errors.Errorf("container %q did not become healthy in time", ctr.ID())
+		}
+		time.Sleep(time.Second)
+	}
+}
+
+// rollback tags the previous image of the update with the raw image name of
+// the container again and restarts the systemd unit.
+func rollback(conn *dbus.Conn, u update, unit string) error {
+	name := trimTransport(u.rawImageName)
+	if err := u.previousImage.TagImage(name); err != nil {
+		return errors.Wrapf(err, "error tagging previous image %s as %q", u.previousImage.ID(), name)
+	}
+	return restartSystemdUnit(conn, unit)
+}
+
+// trimTransport removes the optional docker transport prefix from an image
+// name.
+func trimTransport(imageName string) string {
+	return strings.TrimPrefix(imageName, docker.Transport.Name()+"://")
 }
 
 // imageContainersMap generates a map[image ID] -> [containers using the image]
@@ -230,8 +465,8 @@ func imageContainersMap(runtime *libpod.Runtime) (map[string][]*libpod.Container
 			continue
 		}
 
-		// Skip non-image labels (could be explicitly disabled).
-		if policy != PolicyNewImage {
+		// Skip disabled policies.
+		if policy == PolicyDefault {
 			continue
 		}
 
@@ -243,17 +478,17 @@ func imageContainersMap(runtime *libpod.Runtime) (map[string][]*libpod.Container
 	return imageMap, errors
 }
 
-// newerImageAvailable returns true if there corresponding image on the remote
-// registry is newer.
-func newerImageAvailable(runtime *libpod.Runtime, img *image.Image, origName string, options Options) (bool, error) {
-	remoteRef, err := docker.ParseReference("//" + origName)
+// remoteImageDigest returns the digest of the corresponding image on the
+// remote registry.
+func remoteImageDigest(runtime *libpod.Runtime, img *image.Image, origName string, options Options) (string, error) {
+	remoteRef, err := docker.ParseReference("//" + trimTransport(origName))
 	if err != nil {
-		return false, err
+		return "", err
 	}
 
 	data, err := img.Inspect(context.Background())
 	if err != nil {
-		return false, err
+		return "", err
 	}
 
 	sys := runtime.SystemContext()
@@ -266,20 +501,20 @@ func newerImageAvailable(runtime *libpod.Runtime, img *image.Image, origName str
 
 	remoteImg, err := remoteRef.NewImage(context.Background(), sys)
 	if err != nil {
-		return false, err
+		return "", err
 	}
 
 	rawManifest, _, err := remoteImg.Manifest(context.Background())
 	if err != nil {
-		return false, err
+		return "", err
 	}
 
 	remoteDigest, err := manifest.Digest(rawManifest)
 	if err != nil {
-		return false, err
+		return "", err
 	}
 
-	return img.Digest().String() != remoteDigest.String(), nil
+	return remoteDigest.String(), nil
 }
 
 // updateImage pulls the specified image.
@@ -48,3 +48,29 @@ func TestValidateImageReference(t *testing.T) {
 		}
 	}
 }
+
+func TestLookupPolicy(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected Policy
+		valid    bool
+	}{
+		{input: "", expected: PolicyDefault, valid: true},
+		{input: "disabled", expected: PolicyDefault, valid: true},
+		{input: "image", expected: PolicyNewImage, valid: true},
+		{input: "local", expected: PolicyLocalImage, valid: true},
+		{input: "registry", valid: false},
+	}
+
+	for _, test := range tests {
+		policy, err := LookupPolicy(test.input)
+		if test.valid && err != nil {
+			t.Fatalf("parsing %q should have succeeded: %v", test.input, err)
+		} else if !test.valid && err == nil {
+			t.Fatalf("parsing %q should have failed", test.input)
+		}
+		if policy != test.expected {
+			t.Fatalf("parsing %q returned %q instead of %q", test.input, policy, test.expected)
+		}
+	}
+}
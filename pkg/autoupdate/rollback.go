@@ -0,0 +1,68 @@
+package autoupdate
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/containers/podman/v2/libpod"
+	"github.com/containers/storage/pkg/ioutils"
+	"github.com/pkg/errors"
+)
+
+// rolledBackFile is the name of the file in the static directory of libpod
+// recording the images that updates have been rolled back from.
+const rolledBackFile = "autoupdate-rolledback.json"
+
+// rolledBackImages maps the raw image name of a container to the image an
+// update has been rolled back from: the digest of the image in the registry
+// for PolicyNewImage and the ID of the local image for PolicyLocalImage.
+// Updates to these images are skipped, so the same broken image is not pulled
+// and rolled back on every run.  A different image is tried again.
+type rolledBackImages map[string]string
+
+// rolledBackPath returns the path of the file recording the rolled back
+// images of the runtime.
+func rolledBackPath(runtime *libpod.Runtime) (string, error) {
+	cfg, err := runtime.GetConfig()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(cfg.Engine.StaticDir, rolledBackFile), nil
+}
+
+// loadRolledBackImages reads the rolled back images from the file at path.
+// A missing file records no images.
+func loadRolledBackImages(path string) (rolledBackImages, error) {
+	images := make(rolledBackImages)
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return images, nil
+		}
+		return images, errors.Wrapf(err, "error reading rolled back images from %s", path)
+	}
+	if err := json.Unmarshal(content, &images); err != nil {
+		return make(rolledBackImages), errors.Wrapf(err, "error parsing rolled back images in %s", path)
+	}
+	return images, nil
+}
+
+// save writes the rolled back images to the file at path.
+func (r rolledBackImages) save(path string) error {
+	content, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+	if err := ioutils.AtomicWriteFile(path, content, 0600); err != nil {
+		return errors.Wrapf(err, "error writing rolled back images to %s", path)
+	}
+	return nil
+}
+
+// skip returns true if an update of containers created with rawImageName to
+// candidate has been rolled back before.
+func (r rolledBackImages) skip(rawImageName, candidate string) bool {
+	return candidate != "" && r[rawImageName] == candidate
+}
@@ -0,0 +1,62 @@
+package autoupdate
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRolledBackImages(t *testing.T) {
+	dir, err := ioutil.TempDir("", "autoupdate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, rolledBackFile)
+
+	// A missing file records no images.
+	rolledBack, err := loadRolledBackImages(path)
+	if err != nil {
+		t.Fatalf("loading missing file should have succeeded: %v", err)
+	}
+	if len(rolledBack) != 0 {
+		t.Fatalf("missing file should record no images, got %v", rolledBack)
+	}
+
+	rolledBack["quay.io/foo/bar:tag"] = "sha256:bad"
+	if err := rolledBack.save(path); err != nil {
+		t.Fatal(err)
+	}
+	rolledBack, err = loadRolledBackImages(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		candidate string
+		skip      bool
+	}{
+		{name: "quay.io/foo/bar:tag", candidate: "sha256:bad", skip: true},
+		{name: "quay.io/foo/bar:tag", candidate: "sha256:fixed", skip: false},
+		{name: "quay.io/foo/bar:tag", candidate: "", skip: false},
+		{name: "quay.io/foo/baz:tag", candidate: "sha256:bad", skip: false},
+	}
+	for _, test := range tests {
+		if skip := rolledBack.skip(test.name, test.candidate); skip != test.skip {
+			t.Fatalf("skipping update of %q to %q should be %v", test.name, test.candidate, test.skip)
+		}
+	}
+
+	if err := ioutil.WriteFile(path, []byte("{"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	rolledBack, err = loadRolledBackImages(path)
+	if err == nil {
+		t.Fatal("loading corrupt file should have failed")
+	}
+	if len(rolledBack) != 0 {
+		t.Fatalf("corrupt file should record no images, got %v", rolledBack)
+	}
+}
@@ -2,13 +2,15 @@ package bindings
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"net"
 	"net/http"
 	"net/url"
 	"os"
-	"strconv"
 	"strings"
 	"time"
 
@@ -69,13 +71,11 @@ func NewConnection(ctx context.Context, uri string) (context.Context, error) {
 //
 // A valid URI connection should be scheme://
 // For example tcp://localhost:<port>
+// or tls://<host>:<port>?ca=<path>&cert=<path>&key=<path>
 // or unix:///run/podman/podman.sock
-// or ssh://<user>@<host>[:port]/run/podman/podman.sock?secure=True
+// or ssh://<user>@<host>[:port]/run/podman/podman.sock?fingerprint=<SHA256 host key fingerprint>
 func NewConnectionWithIdentity(ctx context.Context, uri string, identity string) (context.Context, error) {
-	var (
-		err    error
-		secure bool
-	)
+	var err error
 	if v, found := os.LookupEnv("CONTAINER_HOST"); found && uri == "" {
 		uri = v
 	}
@@ -98,11 +98,7 @@ func NewConnectionWithIdentity(ctx context.Context, uri string, identity string)
 	var connection Connection
 	switch _url.Scheme {
 	case "ssh":
-		secure, err = strconv.ParseBool(_url.Query().Get("secure"))
-		if err != nil {
-			secure = false
-		}
-		connection, err = sshClient(_url, secure, passPhrase, identity)
+		connection, err = sshClient(_url, passPhrase, identity)
 	case "unix":
 		if !strings.HasPrefix(uri, "unix:///") {
 			// autofix unix://path_element vs unix:///path_element
@@ -115,6 +111,8 @@ func NewConnectionWithIdentity(ctx context.Context, uri string, identity string)
 			return nil, errors.New("tcp URIs should begin with tcp://")
 		}
 		connection = tcpClient(_url)
+	case "tls":
+		connection, err = tlsClient(_url)
 	default:
 		return nil, errors.Errorf("unable to create connection. %q is not a supported schema", _url.Scheme)
 	}
@@ -144,6 +142,47 @@ func tcpClient(_url *url.URL) Connection {
 	return connection
 }
 
+// tlsClient returns a connection to a service serving the API over TLS. The
+// optional query parameters of the URI are the paths of the PEM encoded CA
+// certificates to verify the server with ("ca", by default the system's) and
+// of the certificate and key of the client for mutual TLS ("cert", "key").
+func tlsClient(_url *url.URL) (Connection, error) {
+	query := _url.Query()
+	config := &tls.Config{
+		ServerName: _url.Hostname(),
+		MinVersion: tls.VersionTLS12,
+	}
+	if ca := query.Get("ca"); ca != "" {
+		pem, err := ioutil.ReadFile(ca)
+		if err != nil {
+			return Connection{}, errors.Wrapf(err, "unable to read the CA certificates")
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return Connection{}, errors.Errorf("no certificates found in %q", ca)
+		}
+		config.RootCAs = pool
+	}
+	if certFile, keyFile := query.Get("cert"), query.Get("key"); certFile != "" || keyFile != "" {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return Connection{}, errors.Wrapf(err, "unable to load the client certificate and key")
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+
+	connection := Connection{URI: _url}
+	connection.Client = &http.Client{
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				return tls.DialWithDialer(&net.Dialer{}, "tcp", _url.Host, config)
+			},
+			DisableCompression: true,
+		},
+	}
+	return connection, nil
+}
+
 // pingNewConnection pings to make sure the RESTFUL service is up
 // and running. it should only be used when initializing a connection
 func pingNewConnection(ctx context.Context) error {
@@ -179,7 +218,7 @@ func pingNewConnection(ctx context.Context) error {
 	return errors.Errorf("ping response was %q", response.StatusCode)
 }
 
-func sshClient(_url *url.URL, secure bool, passPhrase string, identity string) (Connection, error) {
+func sshClient(_url *url.URL, passPhrase string, identity string) (Connection, error) {
 	// if you modify the authmethods or their conditionals, you will also need to make similar
 	// changes in the client (currently cmd/podman/system/connection/add getUDS).
 	authMethods := []ssh.AuthMethod{}
@@ -219,17 +258,9 @@ func sshClient(_url *url.URL, secure bool, passPhrase string, identity string) (
 		port = "22"
 	}
 
-	callback := ssh.InsecureIgnoreHostKey()
-	if secure {
-		host := _url.Hostname()
-		if port != "22" {
-			host = fmt.Sprintf("[%s]:%s", host, port)
-		}
-		key := terminal.HostKey(host)
-		if key != nil {
-			callback = ssh.FixedHostKey(key)
-		}
-	}
+	// The host key must match the fingerprint recorded by podman system
+	// connection add, or else be listed in the known_hosts file.
+	callback := terminal.HostKeyCallback(_url.Query().Get("fingerprint"))
 
 	bastion, err := ssh.Dial("tcp",
 		net.JoinHostPort(_url.Hostname(), port),
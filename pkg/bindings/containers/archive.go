@@ -0,0 +1,101 @@
+package containers
+
+import (
+	"context"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/containers/podman/v2/pkg/bindings"
+	"github.com/containers/podman/v2/pkg/domain/entities"
+	"github.com/pkg/errors"
+)
+
+// Stat returns information about a path in a container. The nameOrID can be
+// a container name or a partial/full ID. An error wrapping os.ErrNotExist is
+// returned if the path does not exist.
+func Stat(ctx context.Context, nameOrID string, path string) (*entities.ContainerStatReport, error) {
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	params := url.Values{}
+	params.Set("path", path)
+	response, err := conn.DoRequest(nil, http.MethodHead, "/containers/%s/archive", params, nil, nameOrID)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	switch {
+	case response.StatusCode == http.StatusNotFound:
+		return nil, errors.Wrapf(os.ErrNotExist, "%q in container %s", path, nameOrID)
+	case !response.IsSuccess():
+		return nil, errors.Errorf("unable to stat %q in container %s: unexpected status %d", path, nameOrID, response.StatusCode)
+	}
+
+	var stat entities.ContainerStatReport
+	statDecoder := base64.NewDecoder(base64.StdEncoding, strings.NewReader(response.Header.Get(entities.ContainerPathStatHeader)))
+	if err := json.NewDecoder(statDecoder).Decode(&stat); err != nil {
+		return nil, errors.Wrapf(err, "unable to decode the stat of %q in container %s", path, nameOrID)
+	}
+	return &stat, nil
+}
+
+// CopyFromArchive extracts the tar archive read from reader into the
+// directory path in a container. The nameOrID can be a container name or a
+// partial/full ID. The optional pause pauses the container while copying,
+// the optional chown sets the owner of the extracted files to the user of
+// the container and the optional noOverwriteDirNonDir fails if a directory
+// would be replaced with a non-directory or vice versa.
+func CopyFromArchive(ctx context.Context, nameOrID string, path string, pause, chown, noOverwriteDirNonDir *bool, reader io.Reader) error {
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+	params := url.Values{}
+	params.Set("path", path)
+	if pause != nil {
+		params.Set("pause", strconv.FormatBool(*pause))
+	}
+	if chown != nil {
+		params.Set("copyUIDGID", strconv.FormatBool(*chown))
+	}
+	if noOverwriteDirNonDir != nil {
+		params.Set("noOverwriteDirNonDir", strconv.FormatBool(*noOverwriteDirNonDir))
+	}
+	response, err := conn.DoRequest(reader, http.MethodPut, "/containers/%s/archive", params, nil, nameOrID)
+	if err != nil {
+		return err
+	}
+	return response.Process(nil)
+}
+
+// CopyToArchive writes a tar archive of path in a container to writer. The
+// nameOrID can be a container name or a partial/full ID. The optional pause
+// pauses the container while copying.
+func CopyToArchive(ctx context.Context, nameOrID string, path string, pause *bool, writer io.Writer) error {
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+	params := url.Values{}
+	params.Set("path", path)
+	if pause != nil {
+		params.Set("pause", strconv.FormatBool(*pause))
+	}
+	response, err := conn.DoRequest(nil, http.MethodGet, "/containers/%s/archive", params, nil, nameOrID)
+	if err != nil {
+		return err
+	}
+	if response.IsSuccess() {
+		defer response.Body.Close()
+		_, err = io.Copy(writer, response.Body)
+		return err
+	}
+	return response.Process(nil)
+}
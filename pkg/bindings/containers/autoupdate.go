@@ -0,0 +1,47 @@
+package containers
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/containers/podman/v2/pkg/auth"
+	"github.com/containers/podman/v2/pkg/bindings"
+	"github.com/containers/podman/v2/pkg/domain/entities"
+	"github.com/pkg/errors"
+)
+
+// AutoUpdate updates the containers according to their auto-update policy.
+// The credentials of the options' authfile are used for contacting the
+// registries. The returned errors are those of the containers which failed
+// to update.
+func AutoUpdate(ctx context.Context, options entities.AutoUpdateOptions) ([]*entities.AutoUpdateReport, []error) {
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return nil, []error{err}
+	}
+	params := url.Values{}
+	params.Set("rollback", strconv.FormatBool(options.Rollback))
+	params.Set("dryRun", strconv.FormatBool(options.DryRun))
+
+	header, err := auth.Header(nil, auth.XRegistryAuthHeader, options.Authfile, "", "")
+	if err != nil {
+		return nil, []error{err}
+	}
+
+	response, err := conn.DoRequest(nil, http.MethodPost, "/auto-update", params, header)
+	if err != nil {
+		return nil, []error{err}
+	}
+	var autoUpdateResponse entities.AutoUpdateResponse
+	if err := response.Process(&autoUpdateResponse); err != nil {
+		return nil, []error{err}
+	}
+
+	var failures []error
+	for _, e := range autoUpdateResponse.Errors {
+		failures = append(failures, errors.New(e))
+	}
+	return autoUpdateResponse.Reports, failures
+}
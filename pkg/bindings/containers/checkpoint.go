@@ -12,7 +12,7 @@ import (
 
 // Checkpoint checkpoints the given container (identified by nameOrID).  All additional
 // options are options and allow for more fine grained control of the checkpoint process.
-func Checkpoint(ctx context.Context, nameOrID string, keep, leaveRunning, tcpEstablished, ignoreRootFS *bool, export *string) (*entities.CheckpointReport, error) {
+func Checkpoint(ctx context.Context, nameOrID string, keep, leaveRunning, tcpEstablished, ignoreRootFS, preCheckPoint, withPrevious *bool, export, createImage *string) (*entities.CheckpointReport, error) {
 	var report entities.CheckpointReport
 	conn, err := bindings.GetClient(ctx)
 	if err != nil {
@@ -31,9 +31,18 @@ func Checkpoint(ctx context.Context, nameOrID string, keep, leaveRunning, tcpEst
 	if ignoreRootFS != nil {
 		params.Set("ignoreRootFS", strconv.FormatBool(*ignoreRootFS))
 	}
+	if preCheckPoint != nil {
+		params.Set("preCheckpoint", strconv.FormatBool(*preCheckPoint))
+	}
+	if withPrevious != nil {
+		params.Set("withPrevious", strconv.FormatBool(*withPrevious))
+	}
 	if export != nil {
 		params.Set("export", *export)
 	}
+	if createImage != nil {
+		params.Set("createImage", *createImage)
+	}
 	response, err := conn.DoRequest(nil, http.MethodPost, "/containers/%s/checkpoint", params, nil, nameOrID)
 	if err != nil {
 		return nil, err
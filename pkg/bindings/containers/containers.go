@@ -12,6 +12,8 @@ import (
 	"github.com/containers/podman/v2/pkg/api/handlers"
 	"github.com/containers/podman/v2/pkg/bindings"
 	"github.com/containers/podman/v2/pkg/domain/entities"
+	jsoniter "github.com/json-iterator/go"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 )
@@ -161,6 +163,42 @@ func Pause(ctx context.Context, nameOrID string) error {
 	return response.Process(nil)
 }
 
+// Rename changes the name of an existing container. The nameOrID can be a
+// container name or a partial/full ID.
+func Rename(ctx context.Context, nameOrID string, newName string) error {
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+	params := url.Values{}
+	params.Set("name", newName)
+	response, err := conn.DoRequest(nil, http.MethodPost, "/containers/%s/rename", params, nil, nameOrID)
+	if err != nil {
+		return err
+	}
+	return response.Process(nil)
+}
+
+// Update changes the resource limits of a container. The nameOrID can be a
+// container name or a partial/full ID. Only the limits set in resources are
+// changed.
+func Update(ctx context.Context, nameOrID string, resources *specs.LinuxResources) error {
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+	resourcesString, err := jsoniter.MarshalToString(resources)
+	if err != nil {
+		return err
+	}
+	stringReader := strings.NewReader(resourcesString)
+	response, err := conn.DoRequest(stringReader, http.MethodPost, "/containers/%s/update", nil, nil, nameOrID)
+	if err != nil {
+		return err
+	}
+	return response.Process(nil)
+}
+
 // Restart restarts a running container. The nameOrID can be a container name
 // or a partial/full ID.  The optional timeout specifies the number of seconds to wait
 // for the running container to stop before killing it.
@@ -0,0 +1,83 @@
+package containers
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/containers/image/v5/types"
+	"github.com/containers/podman/v2/pkg/auth"
+	"github.com/containers/podman/v2/pkg/bindings"
+	"github.com/containers/podman/v2/pkg/domain/entities"
+	"github.com/containers/podman/v2/pkg/util"
+)
+
+// Runlabel executes the command described by the label of an image on the
+// server. The args are appended to the command. The output of the command is
+// written to stdout as it is streamed by the server.
+func Runlabel(ctx context.Context, label string, image string, args []string, options entities.ContainerRunlabelOptions, stdout io.Writer) error {
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+	params := url.Values{}
+	params.Set("image", image)
+	params.Set("label", label)
+	for _, arg := range args {
+		params.Add("args", arg)
+	}
+	params.Set("display", strconv.FormatBool(options.Display))
+	params.Set("name", options.Name)
+	params.Set("opt1", options.Optional1)
+	params.Set("opt2", options.Optional2)
+	params.Set("opt3", options.Optional3)
+	params.Set("pull", strconv.FormatBool(options.Pull))
+	params.Set("quiet", strconv.FormatBool(options.Quiet))
+	params.Set("replace", strconv.FormatBool(options.Replace))
+	if options.SkipTLSVerify != types.OptionalBoolUndefined {
+		params.Set("tlsVerify", strconv.FormatBool(options.SkipTLSVerify != types.OptionalBoolTrue))
+	}
+
+	var username, password string
+	if options.Credentials != "" {
+		creds, err := util.ParseRegistryCreds(options.Credentials)
+		if err != nil {
+			return err
+		}
+		username, password = creds.Username, creds.Password
+	}
+	header, err := auth.Header(nil, auth.XRegistryAuthHeader, options.Authfile, username, password)
+	if err != nil {
+		return err
+	}
+
+	response, err := conn.DoRequest(nil, http.MethodPost, "/containers/runlabel", params, header)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if !response.IsSuccess() {
+		return response.Process(nil)
+	}
+
+	dec := json.NewDecoder(response.Body)
+	for {
+		var report entities.ContainerRunlabelReport
+		if err := dec.Decode(&report); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		if report.Error != "" {
+			return errors.New(report.Error)
+		}
+		if _, err := io.WriteString(stdout, report.Stream); err != nil {
+			return err
+		}
+	}
+}
@@ -343,3 +343,32 @@ func Search(ctx context.Context, term string, opts entities.ImageSearchOptions)
 
 	return results, nil
 }
+
+// RegistryInspect returns the descriptor of the manifest and the platforms of
+// an image in a registry without pulling it.
+func RegistryInspect(ctx context.Context, name string, opts entities.ImageRegistryInspectOptions) (*entities.ImageRegistryInspectReport, error) {
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	params := url.Values{}
+	if opts.SkipTLSVerify != types.OptionalBoolUndefined {
+		// Note: we have to verify if skipped is false.
+		verifyTLS := bool(opts.SkipTLSVerify == types.OptionalBoolFalse)
+		params.Set("tlsVerify", strconv.FormatBool(verifyTLS))
+	}
+
+	// TODO: have a global system context we can pass around (1st argument)
+	header, err := auth.Header(nil, auth.XRegistryAuthHeader, opts.Authfile, opts.Username, opts.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := conn.DoRequest(nil, http.MethodGet, "/distribution/%s/json", params, header, name)
+	if err != nil {
+		return nil, err
+	}
+
+	var report entities.ImageRegistryInspectReport
+	return &report, response.Process(&report)
+}
@@ -0,0 +1,90 @@
+package images
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/containers/podman/v2/pkg/bindings"
+	"github.com/containers/podman/v2/pkg/domain/entities"
+)
+
+// ShowTrust returns the image trust policy of the server. The paths of the
+// options refer to files on the server.
+func ShowTrust(ctx context.Context, options entities.ShowTrustOptions) (*entities.ShowTrustReport, error) {
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	params := url.Values{}
+	params.Set("raw", strconv.FormatBool(options.Raw))
+	if options.PolicyPath != "" {
+		params.Set("policyPath", options.PolicyPath)
+	}
+	if options.RegistryPath != "" {
+		params.Set("registryPath", options.RegistryPath)
+	}
+	response, err := conn.DoRequest(nil, http.MethodGet, "/images/trust", params, nil)
+	if err != nil {
+		return nil, err
+	}
+	var report entities.ShowTrustReport
+	if err := response.Process(&report); err != nil {
+		return nil, err
+	}
+	return &report, nil
+}
+
+// SetTrust sets the default trust policy, if scope is "default", or the trust
+// policy of the registry scope on the server. The paths of the options refer
+// to files on the server.
+func SetTrust(ctx context.Context, scope string, options entities.SetTrustOptions) error {
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+	params := url.Values{}
+	params.Set("scope", scope)
+	params.Set("type", options.Type)
+	for _, key := range options.PubKeysFile {
+		params.Add("pubKeysFile", key)
+	}
+	if options.PolicyPath != "" {
+		params.Set("policyPath", options.PolicyPath)
+	}
+	response, err := conn.DoRequest(nil, http.MethodPost, "/images/trust", params, nil)
+	if err != nil {
+		return err
+	}
+	return response.Process(nil)
+}
+
+// Sign signs the images with a GPG key of the server. The images must include
+// their transport (e.g., docker://). The signatures are stored on the server.
+func Sign(ctx context.Context, images []string, options entities.SignOptions) (*entities.SignReport, error) {
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	params := url.Values{}
+	for _, image := range images {
+		params.Add("images", image)
+	}
+	params.Set("signBy", options.SignBy)
+	if options.Directory != "" {
+		params.Set("directory", options.Directory)
+	}
+	if options.CertDir != "" {
+		params.Set("certDir", options.CertDir)
+	}
+	response, err := conn.DoRequest(nil, http.MethodPost, "/images/sign", params, nil)
+	if err != nil {
+		return nil, err
+	}
+	var report entities.SignReport
+	if err := response.Process(&report); err != nil {
+		return nil, err
+	}
+	return &report, nil
+}
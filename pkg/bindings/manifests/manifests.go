@@ -46,6 +46,26 @@ func Create(ctx context.Context, names, images []string, all *bool) (string, err
 	return idr.ID, response.Process(&idr)
 }
 
+// Exists returns true if a manifest list with the given name exists in local storage.
+// Errors other than a missing manifest list, e.g. of the storage, are returned.
+func Exists(ctx context.Context, name string) (bool, error) {
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return false, err
+	}
+	response, err := conn.DoRequest(nil, http.MethodGet, "/manifests/%s/exists", nil, nil, name)
+	if err != nil {
+		return false, err
+	}
+	if response.StatusCode == http.StatusNotFound {
+		return false, nil
+	}
+	if err := response.Process(nil); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
 // Inspect returns a manifest list for a given name.
 func Inspect(ctx context.Context, name string) (*manifest.Schema2List, error) {
 	var list manifest.Schema2List
@@ -125,24 +145,24 @@ func Push(ctx context.Context, name string, destination *string, all *bool) (str
 	return idr.ID, err
 }
 
-// There is NO annotate endpoint.  this binding could never work
-// Annotate updates the image configuration of a given manifest list
-//func Annotate(ctx context.Context, name, digest string, options image.ManifestAnnotateOpts) (string, error) {
-//	var idr handlers.IDResponse
-//	conn, err := bindings.GetClient(ctx)
-//	if err != nil {
-//		return "", err
-//	}
-//	params := url.Values{}
-//	params.Set("digest", digest)
-//	optionsString, err := jsoniter.MarshalToString(options)
-//	if err != nil {
-//		return "", err
-//	}
-//	stringReader := strings.NewReader(optionsString)
-//	response, err := conn.DoRequest(stringReader, http.MethodPost, "/manifests/%s/annotate", params, name)
-//	if err != nil {
-//		return "", err
-//	}
-//	return idr.ID, response.Process(&idr)
-//}
+// Annotate updates the image configuration of the instance with the given digest
+// in a manifest list.  The ID of the updated manifest list is returned as a string.
+func Annotate(ctx context.Context, name, digest string, options image.ManifestAnnotateOpts) (string, error) {
+	var idr handlers.IDResponse
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	params := url.Values{}
+	params.Set("digest", digest)
+	optionsString, err := jsoniter.MarshalToString(options)
+	if err != nil {
+		return "", err
+	}
+	stringReader := strings.NewReader(optionsString)
+	response, err := conn.DoRequest(stringReader, http.MethodPost, "/manifests/%s/annotate", params, nil, name)
+	if err != nil {
+		return "", err
+	}
+	return idr.ID, response.Process(&idr)
+}
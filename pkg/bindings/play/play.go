@@ -52,3 +52,29 @@ func Kube(ctx context.Context, path string, options entities.PlayKubeOptions) (*
 
 	return &report, nil
 }
+
+// KubeDown stops and removes the pods and volumes created by playing the
+// Kubernetes YAML file at path.
+func KubeDown(ctx context.Context, path string) (*entities.PlayKubeDownReport, error) {
+	var report entities.PlayKubeDownReport
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	response, err := conn.DoRequest(f, http.MethodDelete, "/play/kube", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := response.Process(&report); err != nil {
+		return nil, err
+	}
+
+	return &report, nil
+}
@@ -65,6 +65,18 @@ var _ = Describe("Podman containers ", func() {
 		Expect(code).To(BeNumerically("==", http.StatusNotFound))
 	})
 
+	It("manifest exists", func() {
+		exists, err := manifests.Exists(bt.conn, "larry")
+		Expect(err).To(BeNil())
+		Expect(exists).To(BeFalse())
+
+		id, err := manifests.Create(bt.conn, []string{"quay.io/libpod/foobar:latest"}, []string{}, nil)
+		Expect(err).To(BeNil())
+		exists, err = manifests.Exists(bt.conn, id)
+		Expect(err).To(BeNil())
+		Expect(exists).To(BeTrue())
+	})
+
 	It("add manifest", func() {
 		// add to bogus should 404
 		_, err := manifests.Add(bt.conn, "foobar", image.ManifestAddOpts{})
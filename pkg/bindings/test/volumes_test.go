@@ -1,6 +1,8 @@
 package test_bindings
 
 import (
+	"archive/tar"
+	"bytes"
 	"context"
 	"fmt"
 	"net/http"
@@ -109,6 +111,38 @@ var _ = Describe("Podman volumes", func() {
 		Expect(err).To(BeNil())
 	})
 
+	It("export and import volume", func() {
+		// exporting and importing a bogus volume should result in 404
+		var buf bytes.Buffer
+		err := volumes.Export(connText, "foobar", &buf)
+		code, _ := bindings.CheckResponseCode(err)
+		Expect(code).To(BeNumerically("==", http.StatusNotFound))
+		err = volumes.Import(connText, "foobar", &buf)
+		code, _ = bindings.CheckResponseCode(err)
+		Expect(code).To(BeNumerically("==", http.StatusNotFound))
+
+		vol, err := volumes.Create(connText, entities.VolumeCreateOptions{})
+		Expect(err).To(BeNil())
+		session := bt.runPodman([]string{"run", "--rm", "-v", fmt.Sprintf("%s:/foobar", vol.Name), alpine.name, "sh", "-c", "echo hello > /foobar/test"})
+		session.Wait(45)
+		Expect(session.ExitCode()).To(BeZero())
+
+		err = volumes.Export(connText, vol.Name, &buf)
+		Expect(err).To(BeNil())
+		hdr, err := tar.NewReader(bytes.NewReader(buf.Bytes())).Next()
+		Expect(err).To(BeNil())
+		Expect(hdr.Name).To(Equal("test"))
+
+		vol2, err := volumes.Create(connText, entities.VolumeCreateOptions{})
+		Expect(err).To(BeNil())
+		err = volumes.Import(connText, vol2.Name, &buf)
+		Expect(err).To(BeNil())
+		session = bt.runPodman([]string{"run", "--rm", "-v", fmt.Sprintf("%s:/foobar", vol2.Name), alpine.name, "cat", "/foobar/test"})
+		session.Wait(45)
+		Expect(session.ExitCode()).To(BeZero())
+		Expect(session.Out.Contents()).To(Equal([]byte("hello\n")))
+	})
+
 	It("list volumes", func() {
 		// no volumes should be ok
 		vols, err := volumes.List(connText, nil)
@@ -2,6 +2,7 @@ package volumes
 
 import (
 	"context"
+	"io"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -107,3 +108,37 @@ func Remove(ctx context.Context, nameOrID string, force *bool) error {
 	}
 	return response.Process(nil)
 }
+
+// Export writes an uncompressed tar archive of the contents of the given
+// volume to the writer.
+func Export(ctx context.Context, nameOrID string, writer io.Writer) error {
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+	response, err := conn.DoRequest(nil, http.MethodGet, "/volumes/%s/export", nil, nil, nameOrID)
+	if err != nil {
+		return err
+	}
+	if response.IsSuccess() {
+		defer response.Body.Close()
+		_, err = io.Copy(writer, response.Body)
+		return err
+	}
+	return response.Process(nil)
+}
+
+// Import extracts the tar archive read from the reader into the given volume.
+// The archive may be compressed. Files in the volume are replaced by the files
+// of the archive with the same path, all other files are kept.
+func Import(ctx context.Context, nameOrID string, reader io.Reader) error {
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+	response, err := conn.DoRequest(reader, http.MethodPost, "/volumes/%s/import", nil, nil, nameOrID)
+	if err != nil {
+		return err
+	}
+	return response.Process(nil)
+}
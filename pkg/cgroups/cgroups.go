@@ -255,6 +255,15 @@ func (c *CgroupControl) createCgroupDirectory(controller string) (bool, error) {
 	return true, nil
 }
 
+// writeCgroupFile writes the value to the file of the cgroup in dir.
+func writeCgroupFile(dir, file, value string) error {
+	p := filepath.Join(dir, file)
+	if err := ioutil.WriteFile(p, []byte(value+"\n"), 0644); err != nil {
+		return errors.Wrapf(err, "write %s", p)
+	}
+	return nil
+}
+
 func readFileAsUint64(path string) (uint64, error) {
 	data, err := ioutil.ReadFile(path)
 	if err != nil {
@@ -271,7 +280,7 @@ func readFileAsUint64(path string) (uint64, error) {
 	return ret, nil
 }
 
-// New creates a new cgroup control
+// New creates a new cgroup control and applies the resources to it
 func New(path string, resources *spec.LinuxResources) (*CgroupControl, error) {
 	cgroup2, err := IsCgroup2UnifiedMode()
 	if err != nil {
@@ -294,6 +303,12 @@ func New(path string, resources *spec.LinuxResources) (*CgroupControl, error) {
 		return nil, err
 	}
 
+	if resources != nil {
+		if err := control.Update(resources); err != nil {
+			return nil, err
+		}
+	}
+
 	return control, nil
 }
 
@@ -347,19 +362,24 @@ func Load(path string) (*CgroupControl, error) {
 	return control, nil
 }
 
-// CreateSystemdUnit creates the systemd cgroup
-func (c *CgroupControl) CreateSystemdUnit(path string) error {
+// CreateSystemdUnit creates the systemd cgroup with the specified resources
+func (c *CgroupControl) CreateSystemdUnit(path string, resources *spec.LinuxResources) error {
 	if !c.systemd {
 		return fmt.Errorf("the cgroup controller is not using systemd")
 	}
 
+	properties, err := resourcesToProperties(resources, c.cgroup2)
+	if err != nil {
+		return err
+	}
+
 	conn, err := systemdDbus.New()
 	if err != nil {
 		return err
 	}
 	defer conn.Close()
 
-	return systemdCreate(path, conn)
+	return systemdCreate(path, conn, properties)
 }
 
 // GetUserConnection returns an user connection to D-BUS
@@ -370,18 +390,24 @@ func GetUserConnection(uid int) (*systemdDbus.Conn, error) {
 }
 
 // CreateSystemdUserUnit creates the systemd cgroup for the specified user
-func (c *CgroupControl) CreateSystemdUserUnit(path string, uid int) error {
+// with the specified resources
+func (c *CgroupControl) CreateSystemdUserUnit(path string, uid int, resources *spec.LinuxResources) error {
 	if !c.systemd {
 		return fmt.Errorf("the cgroup controller is not using systemd")
 	}
 
+	properties, err := resourcesToProperties(resources, c.cgroup2)
+	if err != nil {
+		return err
+	}
+
 	conn, err := GetUserConnection(uid)
 	if err != nil {
 		return err
 	}
 	defer conn.Close()
 
-	return systemdCreate(path, conn)
+	return systemdCreate(path, conn, properties)
 }
 
 func dbusAuthConnection(uid int, createBus func(opts ...dbus.ConnOption) (*dbus.Conn, error)) (*dbus.Conn, error) {
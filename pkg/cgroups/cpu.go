@@ -54,7 +54,33 @@ func (c *cpuHandler) Apply(ctr *CgroupControl, res *spec.LinuxResources) error {
 	if res.CPU == nil {
 		return nil
 	}
-	return fmt.Errorf("cpu apply not implemented yet")
+	if ctr.cgroup2 {
+		if res.CPU.Quota == nil && res.CPU.Period == nil {
+			return nil
+		}
+		quota := "max"
+		if res.CPU.Quota != nil && *res.CPU.Quota > 0 {
+			quota = strconv.FormatInt(*res.CPU.Quota, 10)
+		}
+		period := uint64(100000)
+		if res.CPU.Period != nil && *res.CPU.Period > 0 {
+			period = *res.CPU.Period
+		}
+		return writeCgroupFile(filepath.Join(cgroupRoot, ctr.path), "cpu.max", fmt.Sprintf("%s %d", quota, period))
+	}
+
+	path := ctr.getCgroupv1Path(CPU)
+	if res.CPU.Period != nil && *res.CPU.Period > 0 {
+		if err := writeCgroupFile(path, "cpu.cfs_period_us", strconv.FormatUint(*res.CPU.Period, 10)); err != nil {
+			return err
+		}
+	}
+	if res.CPU.Quota != nil && *res.CPU.Quota != 0 {
+		if err := writeCgroupFile(path, "cpu.cfs_quota_us", strconv.FormatInt(*res.CPU.Quota, 10)); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // Create the cgroup
@@ -57,7 +57,21 @@ func (c *cpusetHandler) Apply(ctr *CgroupControl, res *spec.LinuxResources) erro
 	if res.CPU == nil {
 		return nil
 	}
-	return fmt.Errorf("cpuset apply not implemented yet")
+	path := ctr.getCgroupv1Path(CPUset)
+	if ctr.cgroup2 {
+		path = filepath.Join(cgroupRoot, ctr.path)
+	}
+	if res.CPU.Cpus != "" {
+		if err := writeCgroupFile(path, "cpuset.cpus", res.CPU.Cpus); err != nil {
+			return err
+		}
+	}
+	if res.CPU.Mems != "" {
+		if err := writeCgroupFile(path, "cpuset.mems", res.CPU.Mems); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // Create the cgroup
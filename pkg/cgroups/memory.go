@@ -1,8 +1,8 @@
 package cgroups
 
 import (
-	"fmt"
 	"path/filepath"
+	"strconv"
 
 	spec "github.com/opencontainers/runtime-spec/specs-go"
 )
@@ -16,10 +16,17 @@ func getMemoryHandler() *memHandler {
 
 // Apply set the specified constraints
 func (c *memHandler) Apply(ctr *CgroupControl, res *spec.LinuxResources) error {
-	if res.Memory == nil {
+	if res.Memory == nil || res.Memory.Limit == nil {
 		return nil
 	}
-	return fmt.Errorf("memory apply not implemented yet")
+	if ctr.cgroup2 {
+		limit := "max"
+		if *res.Memory.Limit > 0 {
+			limit = strconv.FormatInt(*res.Memory.Limit, 10)
+		}
+		return writeCgroupFile(filepath.Join(cgroupRoot, ctr.path), "memory.max", limit)
+	}
+	return writeCgroupFile(ctr.getCgroupv1Path(Memory), "memory.limit_in_bytes", strconv.FormatInt(*res.Memory.Limit, 10))
 }
 
 // Create the cgroup
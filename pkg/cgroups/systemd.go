@@ -2,14 +2,18 @@ package cgroups
 
 import (
 	"fmt"
+	"math/big"
 	"path/filepath"
+	"strconv"
 	"strings"
 
 	systemdDbus "github.com/coreos/go-systemd/v22/dbus"
 	"github.com/godbus/dbus/v5"
+	spec "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
 )
 
-func systemdCreate(path string, c *systemdDbus.Conn) error {
+func systemdCreate(path string, c *systemdDbus.Conn, resources []systemdDbus.Property) error {
 	slice, name := filepath.Split(path)
 	slice = strings.TrimSuffix(slice, "/")
 
@@ -35,6 +39,7 @@ func systemdCreate(path string, c *systemdDbus.Conn) error {
 			}
 			properties = append(properties, p)
 		}
+		properties = append(properties, resources...)
 
 		ch := make(chan string)
 		_, err := c.StartTransientUnit(name, "replace", properties, ch)
@@ -48,6 +53,105 @@ func systemdCreate(path string, c *systemdDbus.Conn) error {
 	return lastError
 }
 
+// resourcesToProperties converts the resources to the properties of a systemd
+// unit.
+func resourcesToProperties(res *spec.LinuxResources, cgroup2 bool) ([]systemdDbus.Property, error) {
+	var properties []systemdDbus.Property
+	if res == nil {
+		return properties, nil
+	}
+
+	if res.CPU != nil {
+		if res.CPU.Quota != nil && *res.CPU.Quota > 0 {
+			period := uint64(100000)
+			if res.CPU.Period != nil && *res.CPU.Period > 0 {
+				period = *res.CPU.Period
+			}
+			// systemd only accepts the quota per second in steps of
+			// 10ms, round it up.
+			quotaPerSec := uint64(*res.CPU.Quota) * 1000000 / period
+			if quotaPerSec%10000 != 0 {
+				quotaPerSec = (quotaPerSec/10000 + 1) * 10000
+			}
+			properties = append(properties, newProperty("CPUQuotaPerSecUSec", quotaPerSec))
+		}
+		if res.CPU.Cpus != "" {
+			// systemd can set the cpuset of units only on cgroup v2
+			if !cgroup2 {
+				return nil, errors.New("the cpuset of a systemd unit can only be set on cgroup v2")
+			}
+			cpus, err := rangeToBits(res.CPU.Cpus)
+			if err != nil {
+				return nil, err
+			}
+			properties = append(properties, newProperty("AllowedCPUs", cpus))
+		}
+	}
+
+	if res.Memory != nil && res.Memory.Limit != nil && *res.Memory.Limit > 0 {
+		name := "MemoryLimit"
+		if cgroup2 {
+			name = "MemoryMax"
+		}
+		properties = append(properties, newProperty(name, uint64(*res.Memory.Limit)))
+	}
+
+	if res.Pids != nil && res.Pids.Limit > 0 {
+		properties = append(properties,
+			newProperty("TasksAccounting", true),
+			newProperty("TasksMax", uint64(res.Pids.Limit)))
+	}
+
+	return properties, nil
+}
+
+func newProperty(name string, value interface{}) systemdDbus.Property {
+	return systemdDbus.Property{
+		Name:  name,
+		Value: dbus.MakeVariant(value),
+	}
+}
+
+// rangeToBits converts a cpuset list like "0-3,5" to the bitmask expected by
+// systemd, in little-endian byte order.
+func rangeToBits(str string) ([]byte, error) {
+	bits := new(big.Int)
+	for _, r := range strings.Split(str, ",") {
+		r = strings.TrimSpace(r)
+		if r == "" {
+			continue
+		}
+		startEnd := strings.SplitN(r, "-", 2)
+		start, err := strconv.ParseUint(startEnd[0], 10, 32)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid cpuset %q", str)
+		}
+		end := start
+		if len(startEnd) == 2 {
+			end, err = strconv.ParseUint(startEnd[1], 10, 32)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid cpuset %q", str)
+			}
+			if end < start {
+				return nil, errors.Errorf("invalid cpuset %q", str)
+			}
+		}
+		for i := start; i <= end; i++ {
+			bits.SetBit(bits, int(i), 1)
+		}
+	}
+
+	ret := bits.Bytes()
+	if len(ret) == 0 {
+		return nil, errors.Errorf("invalid cpuset %q", str)
+	}
+	// big.Int.Bytes() is big-endian, reverse it
+	for i, j := 0, len(ret)-1; i < j; i, j = i+1, j-1 {
+		ret[i], ret[j] = ret[j], ret[i]
+	}
+	return ret, nil
+}
+
 /*
    systemdDestroyConn is copied from containerd/cgroups/systemd.go file, that
    has the following license:
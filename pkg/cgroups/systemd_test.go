@@ -0,0 +1,30 @@
+package cgroups
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRangeToBits(t *testing.T) {
+	tests := []struct {
+		in  string
+		out []byte
+	}{
+		{in: "0", out: []byte{0x1}},
+		{in: "0-3", out: []byte{0xf}},
+		{in: "1,3", out: []byte{0xa}},
+		{in: "0-2, 8", out: []byte{0x7, 0x1}},
+		{in: "9", out: []byte{0x0, 0x2}},
+	}
+	for _, tc := range tests {
+		bits, err := rangeToBits(tc.in)
+		assert.NoError(t, err, tc.in)
+		assert.Equal(t, tc.out, bits, tc.in)
+	}
+
+	for _, in := range []string{"", "a", "3-1", "1-b"} {
+		_, err := rangeToBits(in)
+		assert.Error(t, err, in)
+	}
+}
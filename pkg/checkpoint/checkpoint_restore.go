@@ -2,11 +2,13 @@ package checkpoint
 
 import (
 	"context"
+	"io"
 	"io/ioutil"
 	"os"
 	"path/filepath"
 
 	"github.com/containers/podman/v2/libpod"
+	"github.com/containers/podman/v2/libpod/define"
 	"github.com/containers/podman/v2/libpod/image"
 	"github.com/containers/podman/v2/pkg/errorhandling"
 	"github.com/containers/podman/v2/pkg/util"
@@ -37,6 +39,164 @@ func crImportFromJSON(filePath string, v interface{}) error {
 // CRImportCheckpoint it the function which imports the information
 // from checkpoint tarball and re-creates the container from that information
 func CRImportCheckpoint(ctx context.Context, runtime *libpod.Runtime, input string, name string) ([]*libpod.Container, error) {
+	return crImportCheckpoint(ctx, runtime, input, name, false)
+}
+
+// CRImportPodCheckpoint imports the information from the checkpoint tarball
+// of a pod and re-creates the pod and all of its containers from that
+// information. The containers are re-created in the order they have to be
+// restored in.
+func CRImportPodCheckpoint(ctx context.Context, runtime *libpod.Runtime, input string) (_ *libpod.Pod, retErr error) {
+	archiveFile, err := os.Open(input)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open pod checkpoint archive for import")
+	}
+	defer errorhandling.CloseQuiet(archiveFile)
+
+	dir, err := ioutil.TempDir("", "pod-checkpoint")
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			logrus.Errorf("could not recursively remove %s: %q", dir, err)
+		}
+	}()
+	if err := archive.Untar(archiveFile, dir, nil); err != nil {
+		return nil, errors.Wrapf(err, "Unpacking of pod checkpoint archive %s failed", input)
+	}
+
+	// Load pod.dump from temporary directory
+	podCheckpoint := new(libpod.PodCheckpoint)
+	if err := crImportFromJSON(filepath.Join(dir, "pod.dump"), podCheckpoint); err != nil {
+		return nil, err
+	}
+
+	pod, err := runtime.RestorePod(ctx, podCheckpoint.Config, podCheckpoint.InfraContainerID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if retErr != nil {
+			if err := runtime.RemovePod(ctx, pod, true, true); err != nil {
+				logrus.Errorf("Error removing pod %s after failed import: %v", pod.ID(), err)
+			}
+		}
+	}()
+
+	for _, ctrID := range podCheckpoint.Containers {
+		ctrs, err := crImportCheckpoint(ctx, runtime, filepath.Join(dir, ctrID+".tar.gz"), "", true)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error importing container %s of pod %s", ctrID, pod.ID())
+		}
+		if len(ctrs) != 1 || ctrs[0].PodID() != pod.ID() {
+			return nil, errors.Errorf("container %s is not part of pod %s", ctrID, pod.ID())
+		}
+	}
+	return pod, nil
+}
+
+// CRIsCheckpointImage returns true if name refers to a local checkpoint
+// image, or is a fully qualified reference of an image that can be pulled.
+// Whether a pulled image is a checkpoint image is only known once it has been
+// pulled. Other names are not restored from images, so a mistyped container
+// name is not looked up on registries.
+func CRIsCheckpointImage(ctx context.Context, runtime *libpod.Runtime, name string) bool {
+	img, err := runtime.ImageRuntime().NewFromLocal(name)
+	if err != nil {
+		return image.IsFullyQualified(name)
+	}
+	annotations, err := img.Annotations(ctx)
+	if err != nil {
+		logrus.Debugf("Error reading annotations of image %s: %v", name, err)
+		return false
+	}
+	_, ok := annotations[define.CheckpointAnnotationName]
+	return ok
+}
+
+// CRRestoreFromImage re-creates a container from a checkpoint image created
+// with 'podman container checkpoint --create-image' and restores it. The image
+// is pulled if it does not exist locally.
+func CRRestoreFromImage(ctx context.Context, runtime *libpod.Runtime, imageName string, options libpod.ContainerCheckpointOptions) (*libpod.Container, error) {
+	rtc, err := runtime.GetConfig()
+	if err != nil {
+		return nil, err
+	}
+	img, err := runtime.ImageRuntime().New(ctx, imageName, rtc.Engine.SignaturePolicyPath, "", os.Stderr, nil, image.SigningOptions{}, nil, util.PullImageMissing)
+	if err != nil {
+		return nil, err
+	}
+	annotations, err := img.Annotations(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := annotations[define.CheckpointAnnotationName]; !ok {
+		return nil, errors.Errorf("image %s is not a checkpoint image", imageName)
+	}
+
+	mountPoint, err := img.Mount(nil, "")
+	if err != nil {
+		return nil, errors.Wrapf(err, "error mounting checkpoint image %s", imageName)
+	}
+	defer func() {
+		if err := img.Unmount(false); err != nil {
+			logrus.Errorf("Error unmounting checkpoint image %s: %v", imageName, err)
+		}
+	}()
+
+	dir, err := ioutil.TempDir("", "checkpoint")
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			logrus.Errorf("could not recursively remove %s: %q", dir, err)
+		}
+	}()
+
+	// Recreate the checkpoint archive from the layer of the image to
+	// restore the container the same way as from an exported checkpoint
+	input := filepath.Join(dir, "checkpoint.tar")
+	if err := crArchiveDirectory(mountPoint, input); err != nil {
+		return nil, errors.Wrapf(err, "error reading checkpoint image %s", imageName)
+	}
+
+	ctrs, err := crImportCheckpoint(ctx, runtime, input, options.Name, false)
+	if err != nil {
+		return nil, err
+	}
+	if len(ctrs) != 1 {
+		return nil, errors.Errorf("checkpoint image %s does not hold a container", imageName)
+	}
+	options.TargetFile = input
+	return ctrs[0], ctrs[0].Restore(ctx, options)
+}
+
+// crArchiveDirectory writes an uncompressed tar archive of dir to dest.
+func crArchiveDirectory(dir, dest string) error {
+	input, err := archive.TarWithOptions(dir, &archive.TarOptions{
+		Compression: archive.Uncompressed,
+	})
+	if err != nil {
+		return err
+	}
+	defer input.Close()
+
+	outFile, err := os.OpenFile(dest, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	defer errorhandling.CloseQuiet(outFile)
+
+	_, err = io.Copy(outFile, input)
+	return err
+}
+
+// crImportCheckpoint re-creates the container from its checkpoint tarball.
+// A container imported with its pod may depend on other containers of the
+// pod, these must have been imported before.
+func crImportCheckpoint(ctx context.Context, runtime *libpod.Runtime, input string, name string, inPod bool) ([]*libpod.Container, error) {
 	// First get the container definition from the
 	// tarball to a temporary directory
 	archiveFile, err := os.Open(input)
@@ -82,7 +242,7 @@ func CRImportCheckpoint(ctx context.Context, runtime *libpod.Runtime, input stri
 	}
 
 	// This should not happen as checkpoints with these options are not exported.
-	if (len(config.Dependencies) > 0) || (len(config.NamedVolumes) > 0) {
+	if (len(config.Dependencies) > 0 && !inPod) || (len(config.NamedVolumes) > 0) {
 		return nil, errors.Errorf("Cannot import checkpoints of containers with named volumes or dependencies")
 	}
 
@@ -4,10 +4,41 @@ package entities
 type AutoUpdateOptions struct {
 	// Authfile to use when contacting registries.
 	Authfile string
+	// Rollback to the previous image if the restarted systemd unit
+	// fails, or if the updated container does not become healthy.
+	Rollback bool
+	// DryRun - only check for updates, without pulling images or
+	// restarting systemd units.
+	DryRun bool
 }
 
-// AutoUpdateReport contains the results from running auto-update.
+// AutoUpdateReport contains the results from running auto-update for a
+// single container.
 type AutoUpdateReport struct {
-	// Units - the restarted systemd units during auto-update.
-	Units []string
+	// ContainerID - ID of the container *before* the update.
+	ContainerID string
+	// ContainerName - name of the container *before* the update.
+	ContainerName string
+	// ImageName - name of the image the container has been created with.
+	ImageName string
+	// Policy - the auto-update policy of the container.
+	Policy string
+	// SystemdUnit - the systemd unit running the container.
+	SystemdUnit string
+	// CurrentDigest - digest of the image the container runs.
+	CurrentDigest string
+	// CandidateDigest - digest of the image the container would be
+	// updated to. Equals CurrentDigest if there is no newer image.
+	CandidateDigest string
+	// Updated - one of "true", "false", "pending" (dry run), "failed" and
+	// "rolled back".
+	Updated string
+}
+
+// AutoUpdateResponse is the response of the auto-update endpoint.
+type AutoUpdateResponse struct {
+	// Reports - the results of the updated containers.
+	Reports []*AutoUpdateReport
+	// Errors - the errors of the containers which failed to update.
+	Errors []string
 }
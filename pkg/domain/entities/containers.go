@@ -10,6 +10,7 @@ import (
 	"github.com/containers/podman/v2/libpod/define"
 	"github.com/containers/podman/v2/pkg/specgen"
 	"github.com/cri-o/ocicni/pkg/ocicni"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
 )
 
 // ContainerRunlabelOptions are the options to execute container-runlabel.
@@ -43,10 +44,19 @@ type ContainerRunlabelOptions struct {
 	// SkipTLSVerify - skip HTTPS and certificate verifications when
 	// contacting registries.
 	SkipTLSVerify types.OptionalBool
+	// Writer - write the output of the command to Writer instead of stdout
+	// and stderr. Stdin is not attached if set, so commands running
+	// interactive containers are rejected.
+	Writer io.Writer
 }
 
-// ContainerRunlabelReport contains the results from executing container-runlabel.
+// ContainerRunlabelReport is streamed while executing container-runlabel
+// remotely.
 type ContainerRunlabelReport struct {
+	// Stream - output of the executed command, stdout and stderr combined.
+	Stream string `json:"stream,omitempty"`
+	// Error - the error the command failed with.
+	Error string `json:"error,omitempty"`
 }
 
 type WaitOptions struct {
@@ -163,14 +173,31 @@ type ContainerExportOptions struct {
 	Output string
 }
 
+// ContainerRenameOptions describes input options for renaming a container.
+type ContainerRenameOptions struct {
+	// NewName is the new name of the container.
+	NewName string
+}
+
+// ContainerUpdateOptions describes input options for updating the resource
+// limits of a container.
+type ContainerUpdateOptions struct {
+	// Resources are the resource limits to change. Limits that are not set
+	// are left unchanged.
+	Resources *specs.LinuxResources
+}
+
 type CheckpointOptions struct {
 	All            bool
+	CreateImage    string
 	Export         string
 	IgnoreRootFS   bool
 	Keep           bool
 	Latest         bool
 	LeaveRunning   bool
+	PreCheckPoint  bool
 	TCPEstablished bool
+	WithPrevious   bool
 }
 
 type CheckpointReport struct {
@@ -223,6 +250,11 @@ type ContainerLogsOptions struct {
 	Names bool
 	// Show logs since this timestamp.
 	Since time.Time
+	// Show logs until this timestamp.
+	Until time.Time
+	// Only show logs of this stream, either "stdout" or "stderr". Logs of
+	// both streams are shown if empty.
+	Stream string
 	// Number of lines to display at the end of the output.
 	Tail int64
 	// Show timestamps in the logs.
@@ -413,6 +445,40 @@ type ContainerCpOptions struct {
 type ContainerCpReport struct {
 }
 
+// ContainerPathStatHeader is the header of the archive endpoints holding the
+// base64 encoded JSON ContainerStatReport of the requested path.
+const ContainerPathStatHeader = "X-Docker-Container-Path-Stat"
+
+// ContainerStatReport describes a path in a container. Symlinks are followed.
+type ContainerStatReport struct {
+	// Name is the base name of the path.
+	Name string `json:"name"`
+	// Size is the size of the file in bytes.
+	Size int64 `json:"size"`
+	// Mode is the file mode and permission bits.
+	Mode os.FileMode `json:"mode"`
+	// Mtime is the time the file was last modified.
+	Mtime time.Time `json:"mtime"`
+	// LinkTarget is always empty as symlinks are followed. It is kept for
+	// compatibility with the Docker API.
+	LinkTarget string `json:"linkTarget"`
+}
+
+// ContainerArchiveOptions describes input options for copying tar archives
+// from and into containers.
+type ContainerArchiveOptions struct {
+	// Pause the container while copying.
+	Pause bool
+	// Chown sets the owner of the copied files to the user of the
+	// container. Only used when copying into a container, the ownership
+	// of the archive is preserved otherwise.
+	Chown bool
+	// NoOverwriteDirNonDir fails if a directory would be replaced with a
+	// non-directory or vice versa. Only used when copying into a
+	// container.
+	NoOverwriteDirNonDir bool
+}
+
 // ContainerStatsOptions describes input options for getting
 // stats on containers
 type ContainerStatsOptions struct {
@@ -163,6 +163,35 @@ type ImagePullReport struct {
 	ID string `json:"id,omitempty"`
 }
 
+// ImageRegistryInspectOptions are the options for inspecting an image in its
+// registry without pulling it.
+type ImageRegistryInspectOptions struct {
+	// Authfile is the path to the authentication file. Ignored for remote
+	// calls.
+	Authfile string
+	// CertDir is the path to certificate directories.  Ignored for remote
+	// calls.
+	CertDir string
+	// Username for authenticating against the registry.
+	Username string
+	// Password for authenticating against the registry.
+	Password string
+	// SkipTLSVerify to skip HTTPS and certificate verification.
+	SkipTLSVerify types.OptionalBool
+}
+
+// ImageRegistryInspectReport describes the manifest of an image in its
+// registry.  It is a superset of Docker's DistributionInspect.
+type ImageRegistryInspectReport struct {
+	// Name is the fully-qualified reference the image was resolved to.
+	Name string
+	// Descriptor of the manifest, including its digest.
+	Descriptor v1.Descriptor
+	// Platforms supported by the image.  For a manifest list, these are
+	// the platforms of its instances.
+	Platforms []v1.Platform
+}
+
 // ImagePushOptions are the arguments for pushing images.
 type ImagePushOptions struct {
 	// Authfile is the path to the authentication file. Ignored for remote
@@ -42,8 +42,30 @@ type PlayKubePod struct {
 	Logs []string
 }
 
+// PlayKubeVolume represents a single volume created by play kube for a
+// PersistentVolumeClaim.
+type PlayKubeVolume struct {
+	// Name - name of the volume created as a result of play kube.
+	Name string
+}
+
 // PlayKubeReport contains the results of running play kube.
 type PlayKubeReport struct {
 	// Pods - pods created by play kube.
 	Pods []PlayKubePod
+	// Volumes - volumes created by play kube.
+	Volumes []PlayKubeVolume
+}
+
+// PlayKubeDownOptions controls tearing down what play kube created.
+type PlayKubeDownOptions struct{}
+
+// PlayKubeDownReport contains the results of tearing down what play kube
+// created.
+type PlayKubeDownReport struct {
+	// Pods - IDs of the pods stopped and removed, along with their
+	// containers.
+	Pods []string
+	// Volumes - names of the removed volumes.
+	Volumes []string
 }
@@ -7,6 +7,8 @@ import (
 
 	"github.com/containers/podman/v2/libpod/define"
 	"github.com/containers/podman/v2/pkg/specgen"
+	"github.com/containers/podman/v2/pkg/util"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
 )
 
 type PodKillOptions struct {
@@ -48,6 +50,37 @@ type PodPauseReport struct {
 	Id   string //nolint
 }
 
+type PodCheckpointOptions struct {
+	All            bool
+	Export         string
+	IgnoreRootFS   bool
+	Keep           bool
+	Latest         bool
+	LeaveRunning   bool
+	TCPEstablished bool
+}
+
+type PodCheckpointReport struct {
+	Errs []error
+	Id   string //nolint
+}
+
+type PodRestoreOptions struct {
+	All             bool
+	IgnoreRootFS    bool
+	IgnoreStaticIP  bool
+	IgnoreStaticMAC bool
+	Import          string
+	Keep            bool
+	Latest          bool
+	TCPEstablished  bool
+}
+
+type PodRestoreReport struct {
+	Errs []error
+	Id   string //nolint
+}
+
 type PodunpauseOptions struct {
 	All    bool
 	Latest bool
@@ -104,6 +137,8 @@ type PodRmReport struct {
 
 type PodCreateOptions struct {
 	CGroupParent       string
+	CPUS               float64
+	CPUSetCPUs         string
 	CreateCommand      []string
 	Hostname           string
 	Infra              bool
@@ -111,8 +146,10 @@ type PodCreateOptions struct {
 	InfraCommand       string
 	InfraConmonPidFile string
 	Labels             map[string]string
+	Memory             int64
 	Name               string
 	Net                *NetOptions
+	PIDsLimit          int64
 	Share              []string
 }
 
@@ -154,6 +191,43 @@ func (p PodCreateOptions) ToPodSpecGen(s *specgen.PodSpecGenerator) {
 
 	// Cgroup
 	s.CgroupParent = p.CGroupParent
+	s.ResourceLimits = p.resourceLimits()
+}
+
+// resourceLimits returns the resource limits of the pod cgroup or nil if
+// none are set.
+func (p PodCreateOptions) resourceLimits() *specs.LinuxResources {
+	var (
+		resources specs.LinuxResources
+		hasLimits bool
+	)
+	if p.CPUS > 0 || p.CPUSetCPUs != "" {
+		resources.CPU = &specs.LinuxCPU{
+			Cpus: p.CPUSetCPUs,
+		}
+		if p.CPUS > 0 {
+			period, quota := util.CoresToPeriodAndQuota(p.CPUS)
+			resources.CPU.Period = &period
+			resources.CPU.Quota = &quota
+		}
+		hasLimits = true
+	}
+	if p.Memory > 0 {
+		resources.Memory = &specs.LinuxMemory{
+			Limit: &p.Memory,
+		}
+		hasLimits = true
+	}
+	if p.PIDsLimit > 0 {
+		resources.Pids = &specs.LinuxPids{
+			Limit: p.PIDsLimit,
+		}
+		hasLimits = true
+	}
+	if !hasLimits {
+		return nil
+	}
+	return &resources
 }
 
 type PodPruneOptions struct {
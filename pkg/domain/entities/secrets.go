@@ -0,0 +1,71 @@
+package entities
+
+import "time"
+
+// SecretCreateReport is the ID of the created secret.
+type SecretCreateReport struct {
+	ID string
+}
+
+// SecretCreateOptions control the creation of a secret.
+type SecretCreateOptions struct {
+	// Driver is the driver storing the secret data.
+	Driver string
+}
+
+// SecretListRequest holds the filters for listing secrets.
+type SecretListRequest struct {
+	Filters map[string]string
+}
+
+// SecretListReport describes a secret in `podman secret ls`.
+type SecretListReport struct {
+	ID        string
+	Name      string
+	Driver    string
+	CreatedAt string
+	UpdatedAt string
+}
+
+// SecretRmOptions control the removal of secrets.
+type SecretRmOptions struct {
+	// All removes all secrets.
+	All bool
+}
+
+// SecretRmReport describes the removal of a single secret.
+type SecretRmReport struct {
+	ID  string
+	Err error
+}
+
+// SecretInfoReport describes a secret. The secret data is never part of the
+// report.
+type SecretInfoReport struct {
+	ID        string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Spec      SecretSpec
+}
+
+// SecretSpec is the specification of a secret.
+type SecretSpec struct {
+	Name   string
+	Driver SecretDriverSpec
+}
+
+// SecretDriverSpec describes the driver of a secret.
+type SecretDriverSpec struct {
+	Name    string
+	Options map[string]string
+}
+
+// swagger:model SecretCreate
+type SecretCreateRequest struct {
+	// User-defined name of the secret.
+	Name string
+	// Base64-url-safe-encoded (RFC 4648) data to store as secret.
+	Data string
+	// Driver represents a driver (default "file")
+	Driver SecretDriverSpec
+}
@@ -10,9 +10,12 @@ import (
 
 // ServiceOptions provides the input for starting an API Service
 type ServiceOptions struct {
-	URI     string         // Path to unix domain socket service should listen on
-	Timeout time.Duration  // duration of inactivity the service should wait before shutting down
-	Command *cobra.Command // CLI command provided. Used in V1 code
+	URI             string         // Path to unix domain socket service should listen on
+	Timeout         time.Duration  // duration of inactivity the service should wait before shutting down
+	Command         *cobra.Command // CLI command provided. Used in V1 code
+	TLSCertFile     string         // PEM encoded certificate of the TLS server (tcp only)
+	TLSKeyFile      string         // PEM encoded private key of the TLS server
+	TLSClientCAFile string         // PEM encoded CA certificates to verify the client certificates with (mutual TLS)
 }
 
 // SystemPruneOptions provides options to prune system.
@@ -95,6 +95,20 @@ type VolumeInfo struct {
 	// TODO: We don't include the volume `Status` for now
 }
 
+// VolumeExportOptions describes the options for exporting the contents of a
+// volume as tar archive.
+type VolumeExportOptions struct {
+	// Output is the path of the file the archive is written to.
+	Output string
+}
+
+// VolumeImportOptions describes the options for importing a tar archive into
+// a volume.
+type VolumeImportOptions struct {
+	// Input is the path of the file the archive is read from.
+	Input string
+}
+
 type VolumeRmOptions struct {
 	All   bool
 	Force bool
@@ -0,0 +1,188 @@
+package abi
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/containers/podman/v2/libpod"
+	"github.com/containers/podman/v2/libpod/define"
+	"github.com/containers/podman/v2/pkg/domain/entities"
+	"github.com/containers/storage/pkg/archive"
+	"github.com/containers/storage/pkg/chrootarchive"
+	"github.com/containers/storage/pkg/idtools"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// ContainerStat returns information about a path in a container. The path is
+// resolved the same way as the paths given to cp.
+func (ic *ContainerEngine) ContainerStat(ctx context.Context, nameOrID string, path string) (*entities.ContainerStatReport, error) {
+	ctr, err := ic.Libpod.LookupContainer(nameOrID)
+	if err != nil {
+		return nil, err
+	}
+	mountPoint, err := ctr.Mount()
+	if err != nil {
+		return nil, err
+	}
+	defer unmountAfterCopy(ctr)
+
+	_, resolvedPath, err := resolveContainerPath(ic.Libpod, ctr, mountPoint, path)
+	if err != nil {
+		return nil, err
+	}
+	info, err := statContainerPath(ctr, path, resolvedPath)
+	if err != nil {
+		return nil, err
+	}
+	return &entities.ContainerStatReport{
+		Name:  filepath.Base(filepath.Clean(path)),
+		Size:  info.Size(),
+		Mode:  info.Mode(),
+		Mtime: info.ModTime(),
+	}, nil
+}
+
+// ContainerCopyToArchive writes a tar archive of a path in a container to
+// writer. The archive holds the path under its base name, or only the
+// contents of the directory if the path ends with "/.". The ownership of the
+// files is mapped to the IDs in the container.
+func (ic *ContainerEngine) ContainerCopyToArchive(ctx context.Context, nameOrID string, path string, writer io.Writer, options entities.ContainerArchiveOptions) error {
+	ctr, err := ic.Libpod.LookupContainer(nameOrID)
+	if err != nil {
+		return err
+	}
+	mountPoint, err := ctr.Mount()
+	if err != nil {
+		return err
+	}
+	defer unmountAfterCopy(ctr)
+
+	if options.Pause {
+		unpause, err := pauseForCopy(ctr)
+		if err != nil {
+			return err
+		}
+		defer unpause()
+	}
+
+	// Copying the root directory always copies its contents.
+	if filepath.Clean(path) == string(os.PathSeparator) {
+		path = string(os.PathSeparator) + "."
+	}
+
+	root, resolvedPath, err := resolveContainerPath(ic.Libpod, ctr, mountPoint, path)
+	if err != nil {
+		return err
+	}
+	if _, err := statContainerPath(ctr, path, resolvedPath); err != nil {
+		return err
+	}
+	idMappingOpts, err := ctr.IDMappings()
+	if err != nil {
+		return errors.Wrapf(err, "error getting IDMappingOptions")
+	}
+
+	// Resolving the path drops a trailing "/." which must be kept to only
+	// archive the contents of a directory.
+	resolvedPath = archive.PreserveTrailingDotOrSeparator(resolvedPath, path)
+	sourceDir, sourceBase := archive.SplitPathDirEntry(resolvedPath)
+	rebaseName := filepath.Base(archive.PreserveTrailingDotOrSeparator(filepath.Clean(path), path))
+
+	reader, err := chrootarchive.Tar(sourceDir, &archive.TarOptions{
+		Compression:      archive.Uncompressed,
+		IncludeFiles:     []string{sourceBase},
+		IncludeSourceDir: true,
+		RebaseNames:      map[string]string{sourceBase: rebaseName},
+		UIDMaps:          idMappingOpts.UIDMap,
+		GIDMaps:          idMappingOpts.GIDMap,
+	}, root)
+	if err != nil {
+		return errors.Wrapf(err, "error archiving %q", path)
+	}
+	defer reader.Close()
+
+	if _, err := io.Copy(writer, reader); err != nil {
+		return errors.Wrapf(err, "error archiving %q", path)
+	}
+	return nil
+}
+
+// ContainerCopyFromArchive extracts the (possibly compressed) tar archive read
+// from reader into a directory in a container. The ownership of the files in
+// the archive is mapped from the IDs in the container, or set to the user of
+// the container if options.Chown is set.
+func (ic *ContainerEngine) ContainerCopyFromArchive(ctx context.Context, nameOrID string, path string, reader io.Reader, options entities.ContainerArchiveOptions) error {
+	ctr, err := ic.Libpod.LookupContainer(nameOrID)
+	if err != nil {
+		return err
+	}
+	mountPoint, err := ctr.Mount()
+	if err != nil {
+		return err
+	}
+	defer unmountAfterCopy(ctr)
+
+	if options.Pause {
+		unpause, err := pauseForCopy(ctr)
+		if err != nil {
+			return err
+		}
+		defer unpause()
+	}
+
+	root, resolvedPath, err := resolveContainerPath(ic.Libpod, ctr, mountPoint, path)
+	if err != nil {
+		return err
+	}
+	info, err := statContainerPath(ctr, path, resolvedPath)
+	if err != nil {
+		return err
+	}
+	if !info.IsDir() {
+		return errors.Wrapf(define.ErrInvalidArg, "%q in container %s is not a directory", path, ctr.ID())
+	}
+	idMappingOpts, err := ctr.IDMappings()
+	if err != nil {
+		return errors.Wrapf(err, "error getting IDMappingOptions")
+	}
+
+	tarOptions := &archive.TarOptions{
+		UIDMaps:              idMappingOpts.UIDMap,
+		GIDMaps:              idMappingOpts.GIDMap,
+		NoOverwriteDirNonDir: options.NoOverwriteDirNonDir,
+	}
+	if options.Chown {
+		user, err := getUser(mountPoint, ctr.User())
+		if err != nil {
+			return err
+		}
+		tarOptions.ChownOpts = &idtools.IDPair{UID: int(user.UID), GID: int(user.GID)}
+	}
+
+	if err := chrootarchive.UntarWithRoot(reader, resolvedPath, tarOptions, root); err != nil {
+		return errors.Wrapf(err, "error extracting archive into %q", path)
+	}
+	return nil
+}
+
+// statContainerPath returns the file info of the resolved path. An error
+// wrapping os.ErrNotExist is returned if the path does not exist.
+func statContainerPath(ctr *libpod.Container, path, resolvedPath string) (os.FileInfo, error) {
+	info, err := os.Stat(resolvedPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, errors.Wrapf(os.ErrNotExist, "%q in container %s", path, ctr.ID())
+		}
+		return nil, err
+	}
+	return info, nil
+}
+
+func unmountAfterCopy(ctr *libpod.Container) {
+	if err := ctr.Unmount(false); err != nil {
+		logrus.Errorf("unable to umount container '%s': %q", ctr.ID(), err)
+	}
+}
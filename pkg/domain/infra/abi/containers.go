@@ -479,6 +479,22 @@ func (ic *ContainerEngine) ContainerExport(ctx context.Context, nameOrID string,
 	return ctr.Export(options.Output)
 }
 
+func (ic *ContainerEngine) ContainerRename(ctx context.Context, nameOrID string, options entities.ContainerRenameOptions) error {
+	ctr, err := ic.Libpod.LookupContainer(nameOrID)
+	if err != nil {
+		return err
+	}
+	return ic.Libpod.RenameContainer(ctx, ctr, options.NewName)
+}
+
+func (ic *ContainerEngine) ContainerUpdate(ctx context.Context, nameOrID string, options entities.ContainerUpdateOptions) error {
+	ctr, err := ic.Libpod.LookupContainer(nameOrID)
+	if err != nil {
+		return err
+	}
+	return ctr.Update(options.Resources)
+}
+
 func (ic *ContainerEngine) ContainerCheckpoint(ctx context.Context, namesOrIds []string, options entities.CheckpointOptions) ([]*entities.CheckpointReport, error) {
 	var (
 		err  error
@@ -490,6 +506,9 @@ func (ic *ContainerEngine) ContainerCheckpoint(ctx context.Context, namesOrIds [
 		TargetFile:     options.Export,
 		IgnoreRootfs:   options.IgnoreRootFS,
 		KeepRunning:    options.LeaveRunning,
+		PreCheckPoint:  options.PreCheckPoint,
+		WithPrevious:   options.WithPrevious,
+		CreateImage:    options.CreateImage,
 	}
 
 	if options.All {
@@ -517,8 +536,9 @@ func (ic *ContainerEngine) ContainerCheckpoint(ctx context.Context, namesOrIds [
 
 func (ic *ContainerEngine) ContainerRestore(ctx context.Context, namesOrIds []string, options entities.RestoreOptions) ([]*entities.RestoreReport, error) {
 	var (
-		cons []*libpod.Container
-		err  error
+		cons             []*libpod.Container
+		checkpointImages []string
+		err              error
 	)
 
 	restoreOptions := libpod.ContainerCheckpointOptions{
@@ -544,12 +564,24 @@ func (ic *ContainerEngine) ContainerRestore(ctx context.Context, namesOrIds []st
 	case options.All:
 		cons, err = ic.Libpod.GetContainers(filterFuncs...)
 	default:
-		cons, err = getContainersByContext(false, options.Latest, namesOrIds, ic.Libpod)
+		// Names of no container are restored from checkpoint images
+		ctrNames := make([]string, 0, len(namesOrIds))
+		for _, nameOrID := range namesOrIds {
+			if _, err := ic.Libpod.LookupContainer(nameOrID); errors.Cause(err) == define.ErrNoSuchCtr && checkpoint.CRIsCheckpointImage(ctx, ic.Libpod, nameOrID) {
+				checkpointImages = append(checkpointImages, nameOrID)
+			} else {
+				ctrNames = append(ctrNames, nameOrID)
+			}
+		}
+		cons, err = getContainersByContext(false, options.Latest, ctrNames, ic.Libpod)
 	}
 	if err != nil {
 		return nil, err
 	}
-	reports := make([]*entities.RestoreReport, 0, len(cons))
+	if options.IgnoreRootFS && options.Import == "" && len(cons) > 0 {
+		return nil, errors.Wrapf(define.ErrInvalidArg, "--ignore-rootfs can only be used with --import or a checkpoint image")
+	}
+	reports := make([]*entities.RestoreReport, 0, len(cons)+len(checkpointImages))
 	for _, con := range cons {
 		err := con.Restore(ctx, restoreOptions)
 		reports = append(reports, &entities.RestoreReport{
@@ -557,6 +589,17 @@ func (ic *ContainerEngine) ContainerRestore(ctx context.Context, namesOrIds []st
 			Id:  con.ID(),
 		})
 	}
+	for _, img := range checkpointImages {
+		report := entities.RestoreReport{Id: img}
+		ctr, err := checkpoint.CRRestoreFromImage(ctx, ic.Libpod, img, restoreOptions)
+		if ctr != nil {
+			report.Id = ctr.ID()
+		}
+		if err != nil {
+			report.Err = errors.Wrapf(err, "no container %s found, restoring it from a checkpoint image failed", img)
+		}
+		reports = append(reports, &report)
+	}
 	return reports, nil
 }
 
@@ -928,6 +971,9 @@ func (ic *ContainerEngine) ContainerLogs(ctx context.Context, containers []strin
 	if options.Writer == nil {
 		return errors.New("no io.Writer set for container logs")
 	}
+	if err := logs.ValidateStream(options.Stream); err != nil {
+		return err
+	}
 
 	var wg sync.WaitGroup
 
@@ -941,6 +987,8 @@ func (ic *ContainerEngine) ContainerLogs(ctx context.Context, containers []strin
 		Details:    options.Details,
 		Follow:     options.Follow,
 		Since:      options.Since,
+		Until:      options.Until,
+		Stream:     options.Stream,
 		Tail:       options.Tail,
 		Timestamps: options.Timestamps,
 		UseName:    options.Names,
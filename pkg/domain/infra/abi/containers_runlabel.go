@@ -3,6 +3,7 @@ package abi
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
@@ -11,6 +12,7 @@ import (
 	"github.com/containers/podman/v2/libpod/image"
 	"github.com/containers/podman/v2/pkg/domain/entities"
 	envLib "github.com/containers/podman/v2/pkg/env"
+	"github.com/containers/podman/v2/pkg/util"
 	"github.com/containers/podman/v2/utils"
 	"github.com/google/shlex"
 	"github.com/pkg/errors"
@@ -37,14 +39,26 @@ func (ic *ContainerEngine) ContainerRunlabel(ctx context.Context, label string,
 		return err
 	}
 
+	var (
+		stdIn  io.Reader = os.Stdin
+		stdOut io.Writer = os.Stdout
+		stdErr io.Writer = os.Stderr
+	)
+	if options.Writer != nil {
+		stdIn = nil
+		stdOut = options.Writer
+		stdErr = options.Writer
+	}
+
 	if options.Display {
-		fmt.Printf("command: %s\n", strings.Join(append([]string{os.Args[0]}, cmd[1:]...), " "))
+		fmt.Fprintf(stdOut, "command: %s\n", strings.Join(append([]string{os.Args[0]}, cmd[1:]...), " "))
 		return nil
 	}
 
-	stdErr := os.Stderr
-	stdOut := os.Stdout
-	stdIn := os.Stdin
+	if options.Writer != nil && isInteractiveCommand(cmd, runlabelImageName(img)) {
+		return errors.Wrapf(define.ErrInvalidArg, "label %s of image %s runs an interactive container, which requires stdin", label, imageRef)
+	}
+
 	if options.Quiet {
 		stdErr = nil
 		stdOut = nil
@@ -97,6 +111,14 @@ func (ic *ContainerEngine) runlabelImage(ctx context.Context, label string, imag
 		SignaturePolicy: options.SignaturePolicy,
 		Authfile:        options.Authfile,
 	}
+	if options.Credentials != "" {
+		creds, err := util.ParseRegistryCreds(options.Credentials)
+		if err != nil {
+			return nil, err
+		}
+		pullOptions.Username = creds.Username
+		pullOptions.Password = creds.Password
+	}
 	if _, err := pull(ctx, ic.Libpod.ImageRuntime(), imageRef, pullOptions, &label); err != nil {
 		return nil, err
 	}
@@ -115,13 +137,7 @@ func generateRunlabelCommand(runlabel string, img *image.Image, args []string, o
 
 	// TODO: How do we get global opts as done in v1?
 
-	// Extract the imageName (or ID).
-	imgNames := img.Names()
-	if len(imgNames) == 0 {
-		imageName = img.ID()
-	} else {
-		imageName = imgNames[0]
-	}
+	imageName = runlabelImageName(img)
 
 	// Use the user-specified name or extract one from the image.
 	if options.Name != "" {
@@ -177,6 +193,35 @@ func generateRunlabelCommand(runlabel string, img *image.Image, args []string, o
 	return cmd, env, nil
 }
 
+// runlabelImageName returns the name of the image, or its ID if it has no
+// name, substituted for IMAGE in a runlabel.
+func runlabelImageName(img *image.Image) string {
+	if imgNames := img.Names(); len(imgNames) > 0 {
+		return imgNames[0]
+	}
+	return img.ID()
+}
+
+// isInteractiveCommand returns true if the command runs a container with stdin
+// attached or a terminal allocated. Only the options preceding the image are
+// checked, if the image is found in the command.
+func isInteractiveCommand(cmd []string, imageName string) bool {
+	for _, arg := range cmd[1:] {
+		switch {
+		case arg == imageName:
+			return false
+		case arg == "--interactive", arg == "--interactive=true", arg == "--tty", arg == "--tty=true":
+			return true
+		case len(arg) > 1 && arg[0] == '-' && arg[1] != '-':
+			// A group of boolean short options, e.g. -dit.
+			if strings.Trim(arg[1:], "diPt") == "" && strings.ContainsAny(arg, "it") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // generateCommand takes a label (string) and converts it to an executable command
 func generateCommand(command, imageName, name, globalOpts string) ([]string, error) {
 	if name == "" {
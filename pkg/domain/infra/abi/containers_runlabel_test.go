@@ -0,0 +1,27 @@
+package abi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsInteractiveCommand(t *testing.T) {
+	tests := []struct {
+		cmd         []string
+		interactive bool
+	}{
+		{[]string{"/usr/bin/podman", "run", "--rm", "alpine", "true"}, false},
+		{[]string{"/usr/bin/podman", "run", "-d", "-p", "8080:80", "alpine", "top"}, false},
+		{[]string{"/usr/bin/podman", "run", "-t", "-i", "--rm", "alpine", "sh"}, true},
+		{[]string{"/usr/bin/podman", "run", "-dit", "alpine", "sh"}, true},
+		{[]string{"/usr/bin/podman", "run", "--tty", "alpine", "sh"}, true},
+		{[]string{"/usr/bin/podman", "run", "--interactive=true", "alpine", "sh"}, true},
+		{[]string{"/usr/bin/podman", "run", "-eTERM=xterm", "alpine", "sh"}, false},
+		// Options of the command in the container are not checked.
+		{[]string{"/usr/bin/podman", "run", "--rm", "alpine", "/bin/install.sh", "-t"}, false},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.interactive, isInteractiveCommand(tt.cmd, "alpine"), "%v", tt.cmd)
+	}
+}
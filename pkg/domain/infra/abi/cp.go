@@ -48,30 +48,14 @@ func (ic *ContainerEngine) ContainerCp(ctx context.Context, source, dest string,
 	if err != nil {
 		return nil, err
 	}
-	defer func() {
-		if err := ctr.Unmount(false); err != nil {
-			logrus.Errorf("unable to umount container '%s': %q", ctr.ID(), err)
-		}
-	}()
+	defer unmountAfterCopy(ctr)
 
 	if options.Pause {
-		if err := ctr.Pause(); err != nil {
-			// An invalid state error is fine.
-			// The container isn't running or is already paused.
-			// TODO: We can potentially start the container while
-			// the copy is running, which still allows a race where
-			// malicious code could mess with the symlink.
-			if errors.Cause(err) != define.ErrCtrStateInvalid {
-				return nil, err
-			}
-		} else {
-			// Only add the defer if we actually paused
-			defer func() {
-				if err := ctr.Unpause(); err != nil {
-					logrus.Errorf("Error unpausing container after copying: %v", err)
-				}
-			}()
+		unpause, err := pauseForCopy(ctr)
+		if err != nil {
+			return nil, err
 		}
+		defer unpause()
 	}
 
 	user, err := getUser(mountPoint, ctr.User())
@@ -125,30 +109,9 @@ func (ic *ContainerEngine) ContainerCp(ctx context.Context, source, dest string,
 		}
 	} else {
 		destOwner = idtools.IDPair{UID: os.Getuid(), GID: os.Getgid()}
-		if isVol, volDestName, volName := isVolumeDestName(srcPath, ctr); isVol { //nolint(gocritic)
-			path, err := pathWithVolumeMount(ic.Libpod, volDestName, volName, srcPath)
-			if err != nil {
-				return nil, errors.Wrapf(err, "error getting source path from volume %s", volDestName)
-			}
-			srcPath = path
-		} else if isBindMount, mount := isBindMountDestName(srcPath, ctr); isBindMount { //nolint(gocritic)
-			path, err := pathWithBindMountSource(mount, srcPath)
-			if err != nil {
-				return nil, errors.Wrapf(err, "error getting source path from bind mount %s", mount.Destination)
-			}
-			srcPath = path
-		} else if filepath.IsAbs(srcPath) { //nolint(gocritic)
-			cleanedPath, err := securejoin.SecureJoin(mountPoint, srcPath)
-			if err != nil {
-				return nil, err
-			}
-			srcPath = cleanedPath
-		} else { //nolint(gocritic)
-			cleanedPath, err := securejoin.SecureJoin(mountPoint, filepath.Join(ctr.WorkingDir(), srcPath))
-			if err != nil {
-				return nil, err
-			}
-			srcPath = cleanedPath
+		_, srcPath, err = resolveContainerPath(ic.Libpod, ctr, mountPoint, srcPath)
+		if err != nil {
+			return nil, err
 		}
 	}
 
@@ -168,6 +131,64 @@ func (ic *ContainerEngine) ContainerCp(ctx context.Context, source, dest string,
 	return &entities.ContainerCpReport{}, err
 }
 
+// pauseForCopy pauses the container while files are copied. The returned
+// function unpauses the container, it does nothing if the container was not
+// paused.
+func pauseForCopy(ctr *libpod.Container) (func(), error) {
+	if err := ctr.Pause(); err != nil {
+		// An invalid state error is fine.
+		// The container isn't running or is already paused.
+		// TODO: We can potentially start the container while
+		// the copy is running, which still allows a race where
+		// malicious code could mess with the symlink.
+		if errors.Cause(err) != define.ErrCtrStateInvalid {
+			return nil, err
+		}
+		return func() {}, nil
+	}
+	return func() {
+		if err := ctr.Unpause(); err != nil {
+			logrus.Errorf("Error unpausing container after copying: %v", err)
+		}
+	}, nil
+}
+
+// resolveContainerPath resolves a path in the container to the path on the
+// host. Paths in volumes and bind mounts resolve to their sources, all other
+// paths to the mounted root filesystem of the container. Relative paths are
+// relative to the working directory of the container. Symlinks are resolved
+// within the returned root, which is the mount point of the volume, the
+// source of the bind mount or the root filesystem.
+func resolveContainerPath(runtime *libpod.Runtime, ctr *libpod.Container, mountPoint, path string) (string, string, error) {
+	if isVol, volDestName, volName := isVolumeDestName(path, ctr); isVol {
+		resolvedPath, err := pathWithVolumeMount(runtime, volDestName, volName, path)
+		if err != nil {
+			return "", "", errors.Wrapf(err, "error getting path from volume %s", volDestName)
+		}
+		vol, err := runtime.GetVolume(volName)
+		if err != nil {
+			return "", "", errors.Wrapf(err, "error getting volume %s", volName)
+		}
+		root, err := vol.MountPoint()
+		if err != nil {
+			return "", "", err
+		}
+		return root, resolvedPath, nil
+	}
+	if isBindMount, mount := isBindMountDestName(path, ctr); isBindMount {
+		resolvedPath, err := pathWithBindMountSource(mount, path)
+		if err != nil {
+			return "", "", errors.Wrapf(err, "error getting path from bind mount %s", mount.Destination)
+		}
+		return mount.Source, resolvedPath, nil
+	}
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(ctr.WorkingDir(), path)
+	}
+	resolvedPath, err := securejoin.SecureJoin(mountPoint, path)
+	return mountPoint, resolvedPath, err
+}
+
 func getUser(mountPoint string, userspec string) (specs.User, error) {
 	uid, gid, _, err := chrootuser.GetUser(mountPoint, userspec)
 	u := specs.User{
@@ -404,7 +425,11 @@ func pathWithVolumeMount(runtime *libpod.Runtime, volDestName, volName, path str
 	if !filepath.IsAbs(path) {
 		path = filepath.Join(string(os.PathSeparator), path)
 	}
-	path, err = securejoin.SecureJoin(destVolume.MountPoint(), strings.TrimPrefix(path, volDestName))
+	mountPoint, err := destVolume.MountPoint()
+	if err != nil {
+		return "", err
+	}
+	path, err = securejoin.SecureJoin(mountPoint, strings.TrimPrefix(path, volDestName))
 	return path, err
 }
 
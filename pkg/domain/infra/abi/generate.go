@@ -48,7 +48,8 @@ func (ic *ContainerEngine) GenerateKube(ctx context.Context, nameOrID string, op
 		err          error
 		ctr          *libpod.Container
 		servicePorts []k8sAPI.ServicePort
-		serviceYAML  k8sAPI.Service
+		serviceYAML  *k8sAPI.Service
+		pvcYAMLs     []*k8sAPI.PersistentVolumeClaim
 	)
 	// Get the container in question.
 	ctr, err = ic.Libpod.LookupContainer(nameOrID)
@@ -68,11 +69,26 @@ func (ic *ContainerEngine) GenerateKube(ctx context.Context, nameOrID string, op
 		return nil, err
 	}
 
+	// Emit a PersistentVolumeClaim for every named volume of the pod.
+	for _, vol := range podYAML.Spec.Volumes {
+		if vol.PersistentVolumeClaim == nil {
+			continue
+		}
+		volume, err := ic.Libpod.LookupVolume(vol.PersistentVolumeClaim.ClaimName)
+		if err != nil {
+			return nil, err
+		}
+		pvcYAMLs = append(pvcYAMLs, volume.GenerateForKube())
+	}
+
+	// Only emit a service document if requested, an empty one is not a
+	// valid Kubernetes object.
 	if options.Service {
-		serviceYAML = libpod.GenerateKubeServiceFromV1Pod(podYAML, servicePorts)
+		service := libpod.GenerateKubeServiceFromV1Pod(podYAML, servicePorts)
+		serviceYAML = &service
 	}
 
-	content, err := generateKubeOutput(podYAML, &serviceYAML)
+	content, err := generateKubeOutput(pvcYAMLs, podYAML, serviceYAML)
 	if err != nil {
 		return nil, err
 	}
@@ -80,14 +96,23 @@ func (ic *ContainerEngine) GenerateKube(ctx context.Context, nameOrID string, op
 	return &entities.GenerateKubeReport{Reader: bytes.NewReader(content)}, nil
 }
 
-func generateKubeOutput(podYAML *k8sAPI.Pod, serviceYAML *k8sAPI.Service) ([]byte, error) {
+func generateKubeOutput(pvcYAMLs []*k8sAPI.PersistentVolumeClaim, podYAML *k8sAPI.Pod, serviceYAML *k8sAPI.Service) ([]byte, error) {
 	var (
 		output            []byte
+		marshalledPVCs    [][]byte
 		marshalledPod     []byte
 		marshalledService []byte
 		err               error
 	)
 
+	for _, pvcYAML := range pvcYAMLs {
+		marshalledPVC, err := yaml.Marshal(pvcYAML)
+		if err != nil {
+			return nil, err
+		}
+		marshalledPVCs = append(marshalledPVCs, marshalledPVC)
+	}
+
 	marshalledPod, err = yaml.Marshal(podYAML)
 	if err != nil {
 		return nil, err
@@ -113,6 +138,10 @@ func generateKubeOutput(podYAML *k8sAPI.Pod, serviceYAML *k8sAPI.Service) ([]byt
 	}
 
 	output = append(output, []byte(fmt.Sprintf(header, podmanVersion.Version))...)
+	for _, marshalledPVC := range marshalledPVCs {
+		output = append(output, marshalledPVC...)
+		output = append(output, []byte("---\n")...)
+	}
 	output = append(output, marshalledPod...)
 	if serviceYAML != nil {
 		output = append(output, []byte("---\n")...)
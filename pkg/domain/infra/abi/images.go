@@ -12,9 +12,11 @@ import (
 	"strconv"
 	"strings"
 
+	buildahUtil "github.com/containers/buildah/util"
 	"github.com/containers/common/pkg/config"
 	"github.com/containers/image/v5/docker"
 	"github.com/containers/image/v5/docker/reference"
+	cimage "github.com/containers/image/v5/image"
 	"github.com/containers/image/v5/manifest"
 	"github.com/containers/image/v5/signature"
 	"github.com/containers/image/v5/transports"
@@ -333,6 +335,106 @@ func (ir *ImageEngine) Inspect(ctx context.Context, namesOrIDs []string, opts en
 	return reports, errs, nil
 }
 
+// RegistryInspect resolves an image in its registry to the descriptor of its
+// manifest and the platforms it supports without pulling it.
+func (ir *ImageEngine) RegistryInspect(ctx context.Context, name string, opts entities.ImageRegistryInspectOptions) (*entities.ImageRegistryInspectReport, error) {
+	sys := &types.SystemContext{}
+	if runtimeSys := ir.Libpod.SystemContext(); runtimeSys != nil {
+		*sys = *runtimeSys
+	}
+	if opts.Authfile != "" {
+		sys.AuthFilePath = opts.Authfile
+	}
+	if opts.CertDir != "" {
+		sys.DockerCertPath = opts.CertDir
+	}
+	sys.DockerInsecureSkipTLSVerify = opts.SkipTLSVerify
+	if opts.Username != "" {
+		sys.DockerAuthConfig = &types.DockerAuthConfig{
+			Username: opts.Username,
+			Password: opts.Password,
+		}
+	}
+
+	// Short names are resolved with the unqualified-search registries.
+	refs, err := buildahUtil.ResolveNameToReferences(ir.Libpod.GetStore(), sys, strings.TrimPrefix(name, docker.Transport.Name()+"://"))
+	if err != nil {
+		return nil, err
+	}
+	var latestErr error
+	for _, ref := range refs {
+		if ref.Transport().Name() != docker.Transport.Name() {
+			continue
+		}
+		report, err := inspectRegistryImage(ctx, sys, ref)
+		if err == nil {
+			return report, nil
+		}
+		latestErr = err
+	}
+	if latestErr == nil {
+		latestErr = errors.Errorf("%q does not refer to an image in a registry", name)
+	}
+	return nil, latestErr
+}
+
+func inspectRegistryImage(ctx context.Context, sys *types.SystemContext, ref types.ImageReference) (*entities.ImageRegistryInspectReport, error) {
+	src, err := ref.NewImageSource(ctx, sys)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error reading image %q", transports.ImageName(ref))
+	}
+	defer src.Close()
+
+	manifestBytes, manifestType, err := src.GetManifest(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error loading manifest of %q", transports.ImageName(ref))
+	}
+	manifestDigest, err := manifest.Digest(manifestBytes)
+	if err != nil {
+		return nil, err
+	}
+	report := entities.ImageRegistryInspectReport{
+		Name: ref.DockerReference().String(),
+		Descriptor: imgspecv1.Descriptor{
+			MediaType: manifestType,
+			Digest:    manifestDigest,
+			Size:      int64(len(manifestBytes)),
+		},
+	}
+
+	if manifest.MIMETypeIsMultiImage(manifestType) {
+		list, err := manifest.ListFromBlob(manifestBytes, manifestType)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error parsing manifest list of %q", transports.ImageName(ref))
+		}
+		index, err := list.ConvertToMIMEType(imgspecv1.MediaTypeImageIndex)
+		if err != nil {
+			return nil, err
+		}
+		for _, instance := range index.(*manifest.OCI1Index).Manifests {
+			if instance.Platform != nil {
+				report.Platforms = append(report.Platforms, *instance.Platform)
+			}
+		}
+		return &report, nil
+	}
+
+	img, err := cimage.FromUnparsedImage(ctx, sys, cimage.UnparsedInstance(src, nil))
+	if err != nil {
+		return nil, err
+	}
+	info, err := img.Inspect(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error inspecting %q", transports.ImageName(ref))
+	}
+	report.Platforms = []imgspecv1.Platform{{
+		Architecture: info.Architecture,
+		OS:           info.Os,
+		Variant:      info.Variant,
+	}}
+	return &report, nil
+}
+
 func (ir *ImageEngine) Push(ctx context.Context, source string, destination string, options entities.ImagePushOptions) error {
 	var writer io.Writer
 	if !options.Quiet {
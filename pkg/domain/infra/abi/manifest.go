@@ -21,6 +21,7 @@ import (
 	"github.com/containers/image/v5/transports"
 	"github.com/containers/image/v5/transports/alltransports"
 	"github.com/containers/image/v5/types"
+	"github.com/containers/podman/v2/libpod/define"
 	libpodImage "github.com/containers/podman/v2/libpod/image"
 	"github.com/containers/podman/v2/pkg/domain/entities"
 	"github.com/opencontainers/go-digest"
@@ -43,6 +44,22 @@ func (ir *ImageEngine) ManifestCreate(ctx context.Context, names, images []strin
 	return imageID, err
 }
 
+// ManifestExists checks if a manifest list with the given name exists in local storage
+func (ir *ImageEngine) ManifestExists(ctx context.Context, name string) (*entities.BoolReport, error) {
+	image, err := ir.Libpod.ImageRuntime().NewFromLocal(name)
+	if err != nil {
+		if errors.Cause(err) == define.ErrNoSuchImage {
+			return &entities.BoolReport{Value: false}, nil
+		}
+		return nil, err
+	}
+	isList, err := image.IsManifestList()
+	if err != nil {
+		return nil, err
+	}
+	return &entities.BoolReport{Value: isList}, nil
+}
+
 // ManifestInspect returns the content of a manifest list or image
 func (ir *ImageEngine) ManifestInspect(ctx context.Context, name string) ([]byte, error) {
 	if newImage, err := ir.Libpod.ImageRuntime().NewFromLocal(name); err == nil {
@@ -243,6 +260,32 @@ func (ir *ImageEngine) ManifestRemove(ctx context.Context, names []string) (stri
 	return "", err
 }
 
+// ManifestRm removes the specified manifest lists from local storage
+func (ir *ImageEngine) ManifestRm(ctx context.Context, names []string) (*entities.ImageRemoveReport, []error) {
+	var (
+		lists    []string
+		rmErrors []error
+	)
+	for _, name := range names {
+		report, err := ir.ManifestExists(ctx, name)
+		switch {
+		case err != nil:
+			rmErrors = append(rmErrors, err)
+		case !report.Value:
+			rmErrors = append(rmErrors, errors.Wrapf(define.ErrNoSuchImage, "%s is not a manifest list", name))
+		default:
+			lists = append(lists, name)
+		}
+	}
+	if len(lists) == 0 {
+		return &entities.ImageRemoveReport{ExitCode: removeErrorsToExitCode(rmErrors)}, rmErrors
+	}
+	report, errs := ir.Remove(ctx, lists, entities.ImageRemoveOptions{})
+	rmErrors = append(rmErrors, errs...)
+	report.ExitCode = removeErrorsToExitCode(rmErrors)
+	return report, rmErrors
+}
+
 // ManifestPush pushes a manifest list or image index to the destination
 func (ir *ImageEngine) ManifestPush(ctx context.Context, names []string, opts entities.ManifestPushOptions) error {
 	listImage, err := ir.Libpod.ImageRuntime().NewFromLocal(names[0])
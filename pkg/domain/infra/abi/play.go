@@ -1,6 +1,8 @@
 package abi
 
 import (
+	"bufio"
+	"bytes"
 	"context"
 	"fmt"
 	"io"
@@ -11,8 +13,10 @@ import (
 	"github.com/containers/buildah/pkg/parse"
 	"github.com/containers/image/v5/types"
 	"github.com/containers/podman/v2/libpod"
+	"github.com/containers/podman/v2/libpod/define"
 	"github.com/containers/podman/v2/libpod/image"
 	"github.com/containers/podman/v2/pkg/domain/entities"
+	"github.com/containers/podman/v2/pkg/specgen"
 	"github.com/containers/podman/v2/pkg/specgen/generate"
 	"github.com/containers/podman/v2/pkg/specgen/generate/kube"
 	"github.com/containers/podman/v2/pkg/util"
@@ -22,6 +26,8 @@ import (
 	"github.com/sirupsen/logrus"
 	v1apps "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
+	v12 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
 )
 
 const (
@@ -31,9 +37,24 @@ const (
 	kubeFilePermission = 0644
 )
 
+// kubeResources holds the objects of a YAML file that pods may refer to.
+type kubeResources struct {
+	configMaps []v1.ConfigMap
+	secrets    []v1.Secret
+	services   []v1.Service
+	// publishedServices holds the indexes of the services whose ports
+	// are already published by a pod.  A host port can only be
+	// published once, so the ports of a service are published by the
+	// first pod matching its selector only.
+	publishedServices map[int]bool
+}
+
 func (ic *ContainerEngine) PlayKube(ctx context.Context, path string, options entities.PlayKubeOptions) (*entities.PlayKubeReport, error) {
 	var (
-		kubeObject v1.ObjectReference
+		report    entities.PlayKubeReport
+		resources kubeResources
+		pvcs      []v1.PersistentVolumeClaim
+		workloads [][]byte
 	)
 
 	content, err := ioutil.ReadFile(path)
@@ -41,36 +62,242 @@ func (ic *ContainerEngine) PlayKube(ctx context.Context, path string, options en
 		return nil, err
 	}
 
-	if err := yaml.Unmarshal(content, &kubeObject); err != nil {
+	documents, err := splitMultiDocYAML(content)
+	if err != nil {
 		return nil, errors.Wrapf(err, "unable to read %q as YAML", path)
 	}
+	if len(documents) == 0 {
+		return nil, errors.Errorf("YAML file %q does not contain any Kubernetes objects", path)
+	}
 
 	// NOTE: pkg/bindings/play is also parsing the file.
 	// A pkg/kube would be nice to refactor and abstract
 	// parts of the K8s-related code.
-	switch kubeObject.Kind {
-	case "Pod":
-		var podYAML v1.Pod
-		var podTemplateSpec v1.PodTemplateSpec
-		if err := yaml.Unmarshal(content, &podYAML); err != nil {
-			return nil, errors.Wrapf(err, "unable to read YAML %q as Kube Pod", path)
-		}
-		podTemplateSpec.ObjectMeta = podYAML.ObjectMeta
-		podTemplateSpec.Spec = podYAML.Spec
-		return ic.playKubePod(ctx, podTemplateSpec.ObjectMeta.Name, &podTemplateSpec, options)
-	case "Deployment":
-		var deploymentYAML v1apps.Deployment
-		if err := yaml.Unmarshal(content, &deploymentYAML); err != nil {
-			return nil, errors.Wrapf(err, "unable to read YAML %q as Kube Deployment", path)
-		}
-		return ic.playKubeDeployment(ctx, &deploymentYAML, options)
-	default:
-		return nil, errors.Errorf("invalid YAML kind: %q. [Pod|Deployment] are the only supported Kubernetes Kinds", kubeObject.Kind)
+	for _, document := range documents {
+		kind, err := getKubeKind(document)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to read %q as YAML", path)
+		}
+
+		switch kind {
+		case "Pod", "Deployment":
+			// Pods are created once all the objects they may
+			// refer to are known.
+			workloads = append(workloads, document)
+		case "PersistentVolumeClaim":
+			var pvcYAML v1.PersistentVolumeClaim
+			if err := yaml.Unmarshal(document, &pvcYAML); err != nil {
+				return nil, errors.Wrapf(err, "unable to read YAML %q as Kube PersistentVolumeClaim", path)
+			}
+			pvcs = append(pvcs, pvcYAML)
+		case "ConfigMap":
+			var cm v1.ConfigMap
+			if err := yaml.Unmarshal(document, &cm); err != nil {
+				return nil, errors.Wrapf(err, "unable to read YAML %q as Kube ConfigMap", path)
+			}
+			resources.configMaps = append(resources.configMaps, cm)
+		case "Secret":
+			var secret v1.Secret
+			if err := yaml.Unmarshal(document, &secret); err != nil {
+				return nil, errors.Wrapf(err, "unable to read YAML %q as Kube Secret", path)
+			}
+			resources.secrets = append(resources.secrets, secret)
+		case "Service":
+			var service v1.Service
+			if err := yaml.Unmarshal(document, &service); err != nil {
+				return nil, errors.Wrapf(err, "unable to read YAML %q as Kube Service", path)
+			}
+			resources.services = append(resources.services, service)
+		default:
+			return nil, errors.Errorf("invalid YAML kind: %q. [Pod|Deployment|PersistentVolumeClaim|ConfigMap|Secret|Service] are the only supported Kubernetes Kinds", kind)
+		}
+	}
+
+	for _, p := range options.ConfigMaps {
+		f, err := os.Open(p)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+
+		cm, err := readConfigMapFromFile(f)
+		if err != nil {
+			return nil, errors.Wrapf(err, "%q", p)
+		}
+
+		resources.configMaps = append(resources.configMaps, cm)
+	}
+
+	for i := range pvcs {
+		volume, err := ic.playKubePVC(ctx, &pvcs[i])
+		if err != nil {
+			return nil, err
+		}
+		if volume != nil {
+			report.Volumes = append(report.Volumes, *volume)
+		}
+	}
+
+	for _, document := range workloads {
+		var workloadReport *entities.PlayKubeReport
+		kind, err := getKubeKind(document)
+		if err != nil {
+			return nil, err
+		}
+		switch kind {
+		case "Pod":
+			var podYAML v1.Pod
+			var podTemplateSpec v1.PodTemplateSpec
+			if err := yaml.Unmarshal(document, &podYAML); err != nil {
+				return nil, errors.Wrapf(err, "unable to read YAML %q as Kube Pod", path)
+			}
+			podTemplateSpec.ObjectMeta = podYAML.ObjectMeta
+			podTemplateSpec.Spec = podYAML.Spec
+			startupProbes, err := kube.StartupProbes(document)
+			if err != nil {
+				return nil, errors.Wrapf(err, "unable to read startup probes of YAML %q", path)
+			}
+			owner := kube.PlayLabelValue(kind, podYAML.ObjectMeta.Name)
+			workloadReport, err = ic.playKubePod(ctx, podTemplateSpec.ObjectMeta.Name, &podTemplateSpec, startupProbes, owner, &resources, options)
+			if err != nil {
+				return nil, err
+			}
+		case "Deployment":
+			var deploymentYAML v1apps.Deployment
+			if err := yaml.Unmarshal(document, &deploymentYAML); err != nil {
+				return nil, errors.Wrapf(err, "unable to read YAML %q as Kube Deployment", path)
+			}
+			startupProbes, err := kube.StartupProbes(document)
+			if err != nil {
+				return nil, errors.Wrapf(err, "unable to read startup probes of YAML %q", path)
+			}
+			workloadReport, err = ic.playKubeDeployment(ctx, &deploymentYAML, startupProbes, &resources, options)
+			if err != nil {
+				return nil, err
+			}
+		}
+		report.Pods = append(report.Pods, workloadReport.Pods...)
+	}
+
+	return &report, nil
+}
+
+// PlayKubeDown stops and removes the pods, along with their containers, and
+// the volumes created by playing the Kubernetes YAML file at path. They are
+// found through the PlayLabel recorded when playing the file.
+func (ic *ContainerEngine) PlayKubeDown(ctx context.Context, path string, options entities.PlayKubeDownOptions) (*entities.PlayKubeDownReport, error) {
+	var report entities.PlayKubeDownReport
+
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	documents, err := splitMultiDocYAML(content)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to read %q as YAML", path)
+	}
+
+	owners := make(map[string]bool)
+	for _, document := range documents {
+		var kubeObject v12.PartialObjectMetadata
+		if err := yaml.Unmarshal(document, &kubeObject); err != nil {
+			return nil, errors.Wrapf(err, "unable to read %q as YAML", path)
+		}
+
+		switch kubeObject.Kind {
+		case "Pod", "Deployment", "PersistentVolumeClaim":
+			owners[kube.PlayLabelValue(kubeObject.Kind, kubeObject.Name)] = true
+		case "ConfigMap", "Secret", "Service":
+			// Nothing is created for these kinds.
+		default:
+			return nil, errors.Errorf("invalid YAML kind: %q. [Pod|Deployment|PersistentVolumeClaim|ConfigMap|Secret|Service] are the only supported Kubernetes Kinds", kubeObject.Kind)
+		}
+	}
+
+	pods, err := ic.Libpod.Pods(func(p *libpod.Pod) bool {
+		return owners[p.Labels()[kube.PlayLabel]]
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range pods {
+		if _, err := p.Stop(ctx, false); err != nil && errors.Cause(err) != define.ErrPodPartialFail {
+			return nil, errors.Wrapf(err, "error stopping pod %s", p.Name())
+		}
+		if err := ic.Libpod.RemovePod(ctx, p, true, true); err != nil {
+			return nil, errors.Wrapf(err, "error removing pod %s", p.Name())
+		}
+		report.Pods = append(report.Pods, p.ID())
+	}
+
+	volumes, err := ic.Libpod.Volumes(func(v *libpod.Volume) bool {
+		return owners[v.Labels()[kube.PlayLabel]]
+	})
+	if err != nil {
+		return nil, err
 	}
+	for _, v := range volumes {
+		if err := ic.Libpod.RemoveVolume(ctx, v, true); err != nil {
+			return nil, errors.Wrapf(err, "error removing volume %s", v.Name())
+		}
+		report.Volumes = append(report.Volumes, v.Name())
+	}
+
+	return &report, nil
+}
 
+// playKubePVC creates the named volume backing a PersistentVolumeClaim. An
+// existing volume of the same name is used as is, in which case no volume
+// is returned.
+func (ic *ContainerEngine) playKubePVC(ctx context.Context, pvcYAML *v1.PersistentVolumeClaim) (*entities.PlayKubeVolume, error) {
+	name := pvcYAML.ObjectMeta.Name
+	if name == "" {
+		return nil, errors.Errorf("PersistentVolumeClaim does not have a name")
+	}
+
+	exists, err := ic.Libpod.HasVolume(name)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		logrus.Debugf("Using existing volume %s for PersistentVolumeClaim", name)
+		return nil, nil
+	}
+
+	labels := make(map[string]string, len(pvcYAML.ObjectMeta.Labels)+1)
+	for k, v := range pvcYAML.ObjectMeta.Labels {
+		labels[k] = v
+	}
+	labels[kube.PlayLabel] = kube.PlayLabelValue(pvcYAML.Kind, name)
+
+	volumeOptions := []libpod.VolumeCreateOption{
+		libpod.WithVolumeName(name),
+		libpod.WithVolumeLabels(labels),
+	}
+	// Volumes generated by generate kube carry their driver and options
+	// as annotations.
+	options := make(map[string]string)
+	for k, v := range pvcYAML.ObjectMeta.Annotations {
+		switch {
+		case k == define.VolumeDriverAnnotation:
+			volumeOptions = append(volumeOptions, libpod.WithVolumeDriver(v))
+		case strings.HasPrefix(k, define.VolumeOptionAnnotationPrefix):
+			options[strings.TrimPrefix(k, define.VolumeOptionAnnotationPrefix)] = v
+		}
+	}
+	if len(options) > 0 {
+		volumeOptions = append(volumeOptions, libpod.WithVolumeOptions(options))
+	}
+
+	vol, err := ic.Libpod.NewVolume(ctx, volumeOptions...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error creating volume for PersistentVolumeClaim %s", name)
+	}
+	return &entities.PlayKubeVolume{Name: vol.Name()}, nil
 }
 
-func (ic *ContainerEngine) playKubeDeployment(ctx context.Context, deploymentYAML *v1apps.Deployment, options entities.PlayKubeOptions) (*entities.PlayKubeReport, error) {
+func (ic *ContainerEngine) playKubeDeployment(ctx context.Context, deploymentYAML *v1apps.Deployment, startupProbes map[string]*v1.Probe, resources *kubeResources, options entities.PlayKubeOptions) (*entities.PlayKubeReport, error) {
 	var (
 		deploymentName string
 		podSpec        v1.PodTemplateSpec
@@ -92,7 +319,7 @@ func (ic *ContainerEngine) playKubeDeployment(ctx context.Context, deploymentYAM
 	// create "replicas" number of pods
 	for i = 0; i < numReplicas; i++ {
 		podName := fmt.Sprintf("%s-pod-%d", deploymentName, i)
-		podReport, err := ic.playKubePod(ctx, podName, &podSpec, options)
+		podReport, err := ic.playKubePod(ctx, podName, &podSpec, startupProbes, kube.PlayLabelValue(deploymentYAML.Kind, deploymentName), resources, options)
 		if err != nil {
 			return nil, errors.Wrapf(err, "error encountered while bringing up pod %s", podName)
 		}
@@ -101,7 +328,11 @@ func (ic *ContainerEngine) playKubeDeployment(ctx context.Context, deploymentYAM
 	return &report, nil
 }
 
-func (ic *ContainerEngine) playKubePod(ctx context.Context, podName string, podYAML *v1.PodTemplateSpec, options entities.PlayKubeOptions) (*entities.PlayKubeReport, error) {
+// playKubePod creates a pod from the given spec. owner identifies the
+// Kubernetes object the pod belongs to and is recorded in the pod's labels.
+// resources are the other objects of the YAML file the pod may refer to.
+// startupProbes are the startupProbes of the containers by name.
+func (ic *ContainerEngine) playKubePod(ctx context.Context, podName string, podYAML *v1.PodTemplateSpec, startupProbes map[string]*v1.Probe, owner string, resources *kubeResources, options entities.PlayKubeOptions) (*entities.PlayKubeReport, error) {
 	var (
 		registryCreds *types.DockerAuthConfig
 		writer        io.Writer
@@ -125,6 +356,28 @@ func (ic *ContainerEngine) playKubePod(ctx context.Context, podName string, podY
 	if err != nil {
 		return nil, err
 	}
+	p.Labels[kube.PlayLabel] = owner
+	for i, service := range resources.services {
+		if resources.publishedServices[i] {
+			continue
+		}
+		ports, err := kube.ServicePortMappings(service, podYAML)
+		if err != nil {
+			return nil, err
+		}
+		if len(ports) == 0 {
+			continue
+		}
+		if resources.publishedServices == nil {
+			resources.publishedServices = make(map[int]bool)
+		}
+		resources.publishedServices[i] = true
+		for _, port := range ports {
+			if !hasHostPort(p.PortMappings, port) {
+				p.PortMappings = append(p.PortMappings, port)
+			}
+		}
+	}
 	if options.Network != "" {
 		switch strings.ToLower(options.Network) {
 		case "bridge", "host":
@@ -168,12 +421,27 @@ func (ic *ContainerEngine) playKubePod(ctx context.Context, podName string, podY
 		DockerInsecureSkipTLSVerify: options.SkipTLSVerify,
 	}
 
-	// map from name to mount point
-	volumes := make(map[string]string)
+	// map from name to the volume providing it
+	volumes := make(map[string]*kube.KubeVolume)
 	for _, volume := range podYAML.Spec.Volumes {
+		if claim := volume.VolumeSource.PersistentVolumeClaim; claim != nil {
+			exists, err := ic.Libpod.HasVolume(claim.ClaimName)
+			if err != nil {
+				return nil, err
+			}
+			if !exists {
+				return nil, errors.Errorf("PersistentVolumeClaim %q of volume %s not found", claim.ClaimName, volume.Name)
+			}
+			volumes[volume.Name] = &kube.KubeVolume{
+				Type:     kube.KubeVolumeTypeNamed,
+				Source:   claim.ClaimName,
+				ReadOnly: claim.ReadOnly,
+			}
+			continue
+		}
 		hostPath := volume.VolumeSource.HostPath
 		if hostPath == nil {
-			return nil, errors.Errorf("HostPath is currently the only supported VolumeSource")
+			return nil, errors.Errorf("HostPath and PersistentVolumeClaim are currently the only supported VolumeSources")
 		}
 		if hostPath.Type != nil {
 			switch *hostPath.Type {
@@ -223,7 +491,10 @@ func (ic *ContainerEngine) playKubePod(ctx context.Context, podName string, podY
 		if err := parse.ValidateVolumeHostDir(hostPath.Path); err != nil {
 			return nil, errors.Wrapf(err, "error in parsing HostPath in YAML")
 		}
-		volumes[volume.Name] = hostPath.Path
+		volumes[volume.Name] = &kube.KubeVolume{
+			Type:   kube.KubeVolumeTypeBindMount,
+			Source: hostPath.Path,
+		}
 	}
 
 	seccompPaths, err := kube.InitializeSeccompPaths(podYAML.ObjectMeta.Annotations, options.SeccompProfileRoot)
@@ -243,22 +514,6 @@ func (ic *ContainerEngine) playKubePod(ctx context.Context, podName string, podY
 		ctrRestartPolicy = libpod.RestartPolicyAlways
 	}
 
-	configMaps := []v1.ConfigMap{}
-	for _, p := range options.ConfigMaps {
-		f, err := os.Open(p)
-		if err != nil {
-			return nil, err
-		}
-		defer f.Close()
-
-		cm, err := readConfigMapFromFile(f)
-		if err != nil {
-			return nil, errors.Wrapf(err, "%q", p)
-		}
-
-		configMaps = append(configMaps, cm)
-	}
-
 	containers := make([]*libpod.Container, 0, len(podYAML.Spec.Containers))
 	for _, container := range podYAML.Spec.Containers {
 		pullPolicy := util.PullImageMissing
@@ -285,10 +540,26 @@ func (ic *ContainerEngine) playKubePod(ctx context.Context, podName string, podY
 			return nil, err
 		}
 
-		specGen, err := kube.ToSpecGen(ctx, container, container.Image, newImage, volumes, pod.ID(), podName, podInfraID, configMaps, seccompPaths, ctrRestartPolicy)
+		specGen, err := kube.ToSpecGen(ctx, container, container.Image, newImage, volumes, pod.ID(), podName, podInfraID, resources.configMaps, resources.secrets, seccompPaths, ctrRestartPolicy)
 		if err != nil {
 			return nil, err
 		}
+		if probe, ok := startupProbes[container.Name]; ok {
+			if specGen.HealthConfig == nil {
+				logrus.Warnf("Ignoring startupProbe of container %s: a startupProbe requires a livenessProbe", container.Name)
+			} else {
+				specGen.StartupHealthConfig, err = kube.ProbeToHealthConfig(probe, container)
+				if err != nil {
+					return nil, errors.Wrapf(err, "invalid startupProbe of container %s", container.Name)
+				}
+			}
+		}
+		if action, ok := podYAML.Annotations[define.KubeHealthCheckOnFailureAnnotation+"/"+container.Name]; ok {
+			specGen.HealthCheckOnFailureAction, err = define.ParseHealthCheckOnFailureAction(action)
+			if err != nil {
+				return nil, err
+			}
+		}
 
 		ctr, err := generate.MakeContainer(ctx, ic.Libpod, specGen)
 		if err != nil {
@@ -345,3 +616,52 @@ func readConfigMapFromFile(r io.Reader) (v1.ConfigMap, error) {
 
 	return cm, nil
 }
+
+// splitMultiDocYAML splits a stream of YAML documents separated by "---"
+// into its documents. Empty documents are skipped.
+func splitMultiDocYAML(content []byte) ([][]byte, error) {
+	var documents [][]byte
+
+	reader := utilyaml.NewYAMLReader(bufio.NewReader(bytes.NewReader(content)))
+	for {
+		document, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		jsonDocument, err := yaml.YAMLToJSON(document)
+		if err != nil {
+			return nil, err
+		}
+		if string(jsonDocument) == "null" {
+			continue
+		}
+		documents = append(documents, document)
+	}
+
+	return documents, nil
+}
+
+// getKubeKind returns the kind of the Kubernetes object in a YAML document.
+func getKubeKind(document []byte) (string, error) {
+	var kubeObject v1.ObjectReference
+
+	if err := yaml.Unmarshal(document, &kubeObject); err != nil {
+		return "", err
+	}
+	return kubeObject.Kind, nil
+}
+
+// hasHostPort reports whether the host port of port is already published
+// by one of mappings.
+func hasHostPort(mappings []specgen.PortMapping, port specgen.PortMapping) bool {
+	for _, m := range mappings {
+		if m.HostPort == port.HostPort && m.Protocol == port.Protocol {
+			return true
+		}
+	}
+	return false
+}
@@ -89,3 +89,82 @@ data:
 		})
 	}
 }
+
+func TestSplitMultiDocYAML(t *testing.T) {
+	tests := []struct {
+		name        string
+		content     string
+		expectError bool
+		expected    []string
+	}{
+		{
+			"SingleDocument",
+			`
+apiVersion: v1
+kind: Pod
+`,
+			false,
+			[]string{"Pod"},
+		},
+		{
+			"MultipleDocuments",
+			`
+apiVersion: v1
+kind: ConfigMap
+---
+apiVersion: v1
+kind: PersistentVolumeClaim
+---
+apiVersion: v1
+kind: Pod
+`,
+			false,
+			[]string{"ConfigMap", "PersistentVolumeClaim", "Pod"},
+		},
+		{
+			"EmptyDocuments",
+			`
+---
+apiVersion: v1
+kind: Pod
+---
+# only a comment
+---
+`,
+			false,
+			[]string{"Pod"},
+		},
+		{
+			"InvalidYAML",
+			`
+apiVersion: v1
+kind: Pod
+---
+Invalid YAML
+kind: Pod
+`,
+			true,
+			nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			documents, err := splitMultiDocYAML([]byte(test.content))
+			if test.expectError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+
+			kinds := []string{}
+			for _, document := range documents {
+				kind, err := getKubeKind(document)
+				assert.NoError(t, err)
+				kinds = append(kinds, kind)
+			}
+			assert.Equal(t, test.expected, kinds)
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/containers/podman/v2/libpod"
 	"github.com/containers/podman/v2/libpod/define"
 	lpfilters "github.com/containers/podman/v2/libpod/filters"
+	"github.com/containers/podman/v2/pkg/checkpoint"
 	"github.com/containers/podman/v2/pkg/domain/entities"
 	"github.com/containers/podman/v2/pkg/signal"
 	"github.com/containers/podman/v2/pkg/specgen"
@@ -45,6 +46,31 @@ func getPodsByContext(all, latest bool, pods []string, runtime *libpod.Runtime)
 	return outpods, err
 }
 
+// filterPodsByContainerStates returns the pods with at least one container in
+// one of the states of with and no container in one of the states of without.
+func filterPodsByContainerStates(pods []*libpod.Pod, with, without []define.ContainerStatus) ([]*libpod.Pod, error) {
+	filtered := make([]*libpod.Pod, 0, len(pods))
+	for _, p := range pods {
+		statuses, err := p.Status()
+		if err != nil {
+			return nil, err
+		}
+		found, excluded := false, false
+		for _, status := range statuses {
+			for _, s := range with {
+				found = found || status == s
+			}
+			for _, s := range without {
+				excluded = excluded || status == s
+			}
+		}
+		if found && !excluded {
+			filtered = append(filtered, p)
+		}
+	}
+	return filtered, nil
+}
+
 func (ic *ContainerEngine) PodExists(ctx context.Context, nameOrID string) (*entities.BoolReport, error) {
 	_, err := ic.Libpod.LookupPod(nameOrID)
 	if err != nil && errors.Cause(err) != define.ErrNoSuchPod {
@@ -109,6 +135,102 @@ func (ic *ContainerEngine) PodPause(ctx context.Context, namesOrIds []string, op
 	return reports, nil
 }
 
+func (ic *ContainerEngine) PodCheckpoint(ctx context.Context, namesOrIds []string, options entities.PodCheckpointOptions) ([]*entities.PodCheckpointReport, error) {
+	checkOpts := libpod.ContainerCheckpointOptions{
+		Keep:           options.Keep,
+		TCPEstablished: options.TCPEstablished,
+		TargetFile:     options.Export,
+		IgnoreRootfs:   options.IgnoreRootFS,
+		KeepRunning:    options.LeaveRunning,
+	}
+	if options.Export != "" && len(namesOrIds) > 1 {
+		return nil, errors.Wrapf(define.ErrInvalidArg, "only one pod can be exported to %s", options.Export)
+	}
+
+	pods, err := getPodsByContext(options.All, options.Latest, namesOrIds, ic.Libpod)
+	if err != nil {
+		return nil, err
+	}
+	if options.All {
+		// Only checkpoint pods with running containers
+		pods, err = filterPodsByContainerStates(pods, []define.ContainerStatus{define.ContainerStateRunning}, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	reports := make([]*entities.PodCheckpointReport, 0, len(pods))
+	for _, p := range pods {
+		report := entities.PodCheckpointReport{Id: p.ID()}
+		errs, err := p.Checkpoint(ctx, checkOpts)
+		if err != nil && errors.Cause(err) != define.ErrPodPartialFail {
+			report.Errs = []error{err}
+		}
+		for id, v := range errs {
+			report.Errs = append(report.Errs, errors.Wrapf(v, "error checkpointing container %s", id))
+		}
+		reports = append(reports, &report)
+	}
+	return reports, nil
+}
+
+func (ic *ContainerEngine) PodRestore(ctx context.Context, namesOrIds []string, options entities.PodRestoreOptions) ([]*entities.PodRestoreReport, error) {
+	var (
+		pods []*libpod.Pod
+		err  error
+	)
+
+	restoreOptions := libpod.ContainerCheckpointOptions{
+		Keep:            options.Keep,
+		TCPEstablished:  options.TCPEstablished,
+		TargetFile:      options.Import,
+		IgnoreRootfs:    options.IgnoreRootFS,
+		IgnoreStaticIP:  options.IgnoreStaticIP,
+		IgnoreStaticMAC: options.IgnoreStaticMAC,
+	}
+
+	switch {
+	case options.Import != "":
+		pod, err := checkpoint.CRImportPodCheckpoint(ctx, ic.Libpod, options.Import)
+		if err != nil {
+			return nil, err
+		}
+		pods = []*libpod.Pod{pod}
+	case options.All:
+		pods, err = ic.Libpod.GetAllPods()
+		if err != nil {
+			return nil, err
+		}
+		// Only restore checkpointed pods, which have exited containers
+		// but no running or paused ones
+		pods, err = filterPodsByContainerStates(pods,
+			[]define.ContainerStatus{define.ContainerStateExited},
+			[]define.ContainerStatus{define.ContainerStateRunning, define.ContainerStatePaused})
+		if err != nil {
+			return nil, err
+		}
+	default:
+		pods, err = getPodsByContext(false, options.Latest, namesOrIds, ic.Libpod)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	reports := make([]*entities.PodRestoreReport, 0, len(pods))
+	for _, p := range pods {
+		report := entities.PodRestoreReport{Id: p.ID()}
+		errs, err := p.Restore(ctx, restoreOptions)
+		if err != nil && errors.Cause(err) != define.ErrPodPartialFail {
+			report.Errs = []error{err}
+		}
+		for id, v := range errs {
+			report.Errs = append(report.Errs, errors.Wrapf(v, "error restoring container %s", id))
+		}
+		reports = append(reports, &report)
+	}
+	return reports, nil
+}
+
 func (ic *ContainerEngine) PodUnpause(ctx context.Context, namesOrIds []string, options entities.PodunpauseOptions) ([]*entities.PodUnpauseReport, error) {
 	reports := []*entities.PodUnpauseReport{}
 	pods, err := getPodsByContext(options.All, options.Latest, namesOrIds, ic.Libpod)
@@ -0,0 +1,155 @@
+package abi
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+
+	"github.com/containers/podman/v2/libpod/define"
+	"github.com/containers/podman/v2/pkg/domain/entities"
+	"github.com/containers/podman/v2/pkg/secrets"
+	"github.com/pkg/errors"
+)
+
+func (ic *ContainerEngine) SecretCreate(ctx context.Context, name string, reader io.Reader, options entities.SecretCreateOptions) (*entities.SecretCreateReport, error) {
+	data, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+	manager, err := ic.Libpod.SecretsManager()
+	if err != nil {
+		return nil, err
+	}
+	driverOptions := make(map[string]string)
+
+	if options.Driver == "" {
+		options.Driver = secrets.FileDriver
+	}
+	if options.Driver == secrets.FileDriver {
+		driverOptions["path"] = filepath.Join(ic.Libpod.GetSecretsStorageDir(), "filedriver")
+	}
+	secretID, err := manager.Store(name, data, options.Driver, driverOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entities.SecretCreateReport{
+		ID: secretID,
+	}, nil
+}
+
+func (ic *ContainerEngine) SecretInspect(ctx context.Context, nameOrIDs []string) ([]*entities.SecretInfoReport, []error, error) {
+	manager, err := ic.Libpod.SecretsManager()
+	if err != nil {
+		return nil, nil, err
+	}
+	errs := make([]error, 0, len(nameOrIDs))
+	reports := make([]*entities.SecretInfoReport, 0, len(nameOrIDs))
+	for _, nameOrID := range nameOrIDs {
+		secret, err := manager.Lookup(nameOrID)
+		if err != nil {
+			if errors.Cause(err) == secrets.ErrNoSuchSecret {
+				errs = append(errs, err)
+				continue
+			}
+			return nil, nil, errors.Wrapf(err, "error inspecting secret %s", nameOrID)
+		}
+		reports = append(reports, secretToReport(secret))
+	}
+	return reports, errs, nil
+}
+
+func (ic *ContainerEngine) SecretList(ctx context.Context) ([]*entities.SecretInfoReport, error) {
+	manager, err := ic.Libpod.SecretsManager()
+	if err != nil {
+		return nil, err
+	}
+	secretList, err := manager.List()
+	if err != nil {
+		return nil, err
+	}
+	reports := make([]*entities.SecretInfoReport, 0, len(secretList))
+	for i := range secretList {
+		reports = append(reports, secretToReport(&secretList[i]))
+	}
+	return reports, nil
+}
+
+func (ic *ContainerEngine) SecretRm(ctx context.Context, nameOrIDs []string, options entities.SecretRmOptions) ([]*entities.SecretRmReport, error) {
+	manager, err := ic.Libpod.SecretsManager()
+	if err != nil {
+		return nil, err
+	}
+	toRemove := nameOrIDs
+	if options.All {
+		allSecrets, err := manager.List()
+		if err != nil {
+			return nil, err
+		}
+		for _, secr := range allSecrets {
+			toRemove = append(toRemove, secr.ID)
+		}
+	}
+	reports := make([]*entities.SecretRmReport, 0, len(toRemove))
+	for _, nameOrID := range toRemove {
+		if err := ic.secretInUse(nameOrID); err != nil {
+			reports = append(reports, &entities.SecretRmReport{Err: err, ID: nameOrID})
+			continue
+		}
+		deletedID, err := manager.Delete(nameOrID)
+		switch {
+		case err == nil:
+			reports = append(reports, &entities.SecretRmReport{ID: deletedID})
+		case errors.Cause(err) == secrets.ErrNoSuchSecret:
+			reports = append(reports, &entities.SecretRmReport{Err: err, ID: nameOrID})
+		default:
+			return nil, err
+		}
+	}
+	return reports, nil
+}
+
+// secretInUse returns an error if the secret is used by a container.
+func (ic *ContainerEngine) secretInUse(nameOrID string) error {
+	manager, err := ic.Libpod.SecretsManager()
+	if err != nil {
+		return err
+	}
+	secret, err := manager.Lookup(nameOrID)
+	if err != nil {
+		// Let the removal report the error.
+		return nil
+	}
+	ctrs, err := ic.Libpod.GetAllContainers()
+	if err != nil {
+		return err
+	}
+	for _, ctr := range ctrs {
+		for _, s := range ctr.Secrets() {
+			if s.ID == secret.ID {
+				return errors.Wrapf(define.ErrSecretBeingUsed, "secret %s is in use by container %s", secret.Name, ctr.ID())
+			}
+		}
+		for _, s := range ctr.EnvSecrets() {
+			if s.ID == secret.ID {
+				return errors.Wrapf(define.ErrSecretBeingUsed, "secret %s is in use by container %s", secret.Name, ctr.ID())
+			}
+		}
+	}
+	return nil
+}
+
+func secretToReport(secret *secrets.Secret) *entities.SecretInfoReport {
+	return &entities.SecretInfoReport{
+		ID:        secret.ID,
+		CreatedAt: secret.CreatedAt,
+		UpdatedAt: secret.CreatedAt,
+		Spec: entities.SecretSpec{
+			Name: secret.Name,
+			Driver: entities.SecretDriverSpec{
+				Name: secret.Driver,
+			},
+		},
+	}
+}
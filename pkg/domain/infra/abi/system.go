@@ -285,10 +285,18 @@ func (ic *ContainerEngine) SystemDf(ctx context.Context, options entities.System
 	var reclaimableSize int64
 	for _, v := range vols {
 		var consInUse int
-		volSize, err := sizeOfPath(v.MountPoint())
+		mountPoint, err := v.MountPoint()
 		if err != nil {
 			return nil, err
 		}
+		var volSize int64
+		// Volumes of volume plugins have no mountpoint while unmounted.
+		if mountPoint != "" {
+			volSize, err = sizeOfPath(mountPoint)
+			if err != nil {
+				return nil, err
+			}
+		}
 		inUse, err := v.VolumeInUse()
 		if err != nil {
 			return nil, err
@@ -2,6 +2,7 @@ package abi
 
 import (
 	"context"
+	"os"
 
 	"github.com/containers/podman/v2/libpod"
 	"github.com/containers/podman/v2/libpod/define"
@@ -38,6 +39,35 @@ func (ic *ContainerEngine) VolumeCreate(ctx context.Context, opts entities.Volum
 	return &entities.IDOrNameResponse{IDOrName: vol.Name()}, nil
 }
 
+// VolumeExport writes a tar archive of the contents of the volume to the output
+// file.
+func (ic *ContainerEngine) VolumeExport(ctx context.Context, nameOrID string, options entities.VolumeExportOptions) error {
+	vol, err := ic.Libpod.LookupVolume(nameOrID)
+	if err != nil {
+		return err
+	}
+	output, err := os.Create(options.Output)
+	if err != nil {
+		return err
+	}
+	defer output.Close()
+	return vol.Export(output)
+}
+
+// VolumeImport extracts the tar archive in the input file into the volume.
+func (ic *ContainerEngine) VolumeImport(ctx context.Context, nameOrID string, options entities.VolumeImportOptions) error {
+	vol, err := ic.Libpod.LookupVolume(nameOrID)
+	if err != nil {
+		return err
+	}
+	input, err := os.Open(options.Input)
+	if err != nil {
+		return err
+	}
+	defer input.Close()
+	return vol.Import(input)
+}
+
 func (ic *ContainerEngine) VolumeRm(ctx context.Context, namesOrIds []string, opts entities.VolumeRmOptions) ([]*entities.VolumeRmReport, error) {
 	var (
 		err     error
@@ -111,10 +141,14 @@ func (ic *ContainerEngine) VolumeInspect(ctx context.Context, namesOrIds []strin
 		if err != nil {
 			return nil, nil, err
 		}
+		mountPoint, err := v.MountPoint()
+		if err != nil {
+			return nil, nil, err
+		}
 		config := entities.VolumeConfigResponse{
 			Name:       v.Name(),
 			Driver:     v.Driver(),
-			Mountpoint: v.MountPoint(),
+			Mountpoint: mountPoint,
 			CreatedAt:  v.CreatedTime(),
 			Labels:     v.Labels(),
 			Scope:      v.Scope(),
@@ -166,10 +200,14 @@ func (ic *ContainerEngine) VolumeList(ctx context.Context, opts entities.VolumeL
 		if err != nil {
 			return nil, err
 		}
+		mountPoint, err := v.MountPoint()
+		if err != nil {
+			return nil, err
+		}
 		config := entities.VolumeConfigResponse{
 			Name:       v.Name(),
 			Driver:     v.Driver(),
-			Mountpoint: v.MountPoint(),
+			Mountpoint: mountPoint,
 			CreatedAt:  v.CreatedTime(),
 			Labels:     v.Labels(),
 			Scope:      v.Scope(),
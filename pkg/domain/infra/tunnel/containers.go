@@ -15,6 +15,7 @@ import (
 	"github.com/containers/image/v5/docker/reference"
 	"github.com/containers/podman/v2/libpod/define"
 	"github.com/containers/podman/v2/libpod/events"
+	"github.com/containers/podman/v2/libpod/logs"
 	"github.com/containers/podman/v2/pkg/api/handlers"
 	"github.com/containers/podman/v2/pkg/bindings"
 	"github.com/containers/podman/v2/pkg/bindings/containers"
@@ -27,7 +28,7 @@ import (
 )
 
 func (ic *ContainerEngine) ContainerRunlabel(ctx context.Context, label string, image string, args []string, options entities.ContainerRunlabelOptions) error {
-	return errors.New("not implemented")
+	return containers.Runlabel(ic.ClientCxt, label, image, args, options, os.Stdout)
 }
 
 func (ic *ContainerEngine) ContainerExists(ctx context.Context, nameOrID string, options entities.ContainerExistsOptions) (*entities.BoolReport, error) {
@@ -277,6 +278,14 @@ func (ic *ContainerEngine) ContainerExport(ctx context.Context, nameOrID string,
 	return containers.Export(ic.ClientCxt, nameOrID, w)
 }
 
+func (ic *ContainerEngine) ContainerRename(ctx context.Context, nameOrID string, options entities.ContainerRenameOptions) error {
+	return containers.Rename(ic.ClientCxt, nameOrID, options.NewName)
+}
+
+func (ic *ContainerEngine) ContainerUpdate(ctx context.Context, nameOrID string, options entities.ContainerUpdateOptions) error {
+	return containers.Update(ic.ClientCxt, nameOrID, options.Resources)
+}
+
 func (ic *ContainerEngine) ContainerCheckpoint(ctx context.Context, namesOrIds []string, options entities.CheckpointOptions) ([]*entities.CheckpointReport, error) {
 	var (
 		err  error
@@ -303,7 +312,7 @@ func (ic *ContainerEngine) ContainerCheckpoint(ctx context.Context, namesOrIds [
 	}
 	reports := make([]*entities.CheckpointReport, 0, len(ctrs))
 	for _, c := range ctrs {
-		report, err := containers.Checkpoint(ic.ClientCxt, c.ID, &options.Keep, &options.LeaveRunning, &options.TCPEstablished, &options.IgnoreRootFS, &options.Export)
+		report, err := containers.Checkpoint(ic.ClientCxt, c.ID, &options.Keep, &options.LeaveRunning, &options.TCPEstablished, &options.IgnoreRootFS, &options.PreCheckPoint, &options.WithPrevious, &options.Export, &options.CreateImage)
 		if err != nil {
 			reports = append(reports, &entities.CheckpointReport{Id: c.ID, Err: err})
 		}
@@ -358,18 +367,26 @@ func (ic *ContainerEngine) ContainerCreate(ctx context.Context, s *specgen.SpecG
 }
 
 func (ic *ContainerEngine) ContainerLogs(_ context.Context, nameOrIDs []string, options entities.ContainerLogsOptions) error {
+	if err := logs.ValidateStream(options.Stream); err != nil {
+		return err
+	}
 	since := options.Since.Format(time.RFC3339)
 	tail := strconv.FormatInt(options.Tail, 10)
-	stdout := options.Writer != nil
+	stdout := options.Writer != nil && options.Stream != logs.StderrStream
+	stderr := options.Writer != nil && options.Stream != logs.StdoutStream
 	opts := containers.LogOptions{
 		Follow:     &options.Follow,
 		Since:      &since,
-		Stderr:     &stdout,
+		Stderr:     &stderr,
 		Stdout:     &stdout,
 		Tail:       &tail,
 		Timestamps: &options.Timestamps,
 		Until:      nil,
 	}
+	if !options.Until.IsZero() {
+		until := options.Until.Format(time.RFC3339Nano)
+		opts.Until = &until
+	}
 
 	var err error
 	outCh := make(chan string)
@@ -731,10 +748,6 @@ func (ic *ContainerEngine) ContainerPort(ctx context.Context, nameOrID string, o
 	return reports, nil
 }
 
-func (ic *ContainerEngine) ContainerCp(ctx context.Context, source, dest string, options entities.ContainerCpOptions) (*entities.ContainerCpReport, error) {
-	return nil, errors.New("not implemented")
-}
-
 // Shutdown Libpod engine
 func (ic *ContainerEngine) Shutdown(_ context.Context) {
 }
@@ -0,0 +1,175 @@
+package tunnel
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/containers/podman/v2/pkg/bindings/containers"
+	"github.com/containers/podman/v2/pkg/domain/entities"
+	"github.com/containers/storage/pkg/archive"
+	"github.com/containers/storage/pkg/idtools"
+	"github.com/pkg/errors"
+)
+
+func (ic *ContainerEngine) ContainerStat(ctx context.Context, nameOrID string, path string) (*entities.ContainerStatReport, error) {
+	return containers.Stat(ic.ClientCxt, nameOrID, path)
+}
+
+func (ic *ContainerEngine) ContainerCopyFromArchive(ctx context.Context, nameOrID string, path string, reader io.Reader, options entities.ContainerArchiveOptions) error {
+	return containers.CopyFromArchive(ic.ClientCxt, nameOrID, path, &options.Pause, &options.Chown, &options.NoOverwriteDirNonDir, reader)
+}
+
+func (ic *ContainerEngine) ContainerCopyToArchive(ctx context.Context, nameOrID string, path string, writer io.Writer, options entities.ContainerArchiveOptions) error {
+	return containers.CopyToArchive(ic.ClientCxt, nameOrID, path, &options.Pause, writer)
+}
+
+// ContainerCp copies files between a container and the local host. The
+// files are transferred as tar archives through the archive endpoints, the
+// destination paths are resolved the same way as by the local cp.
+func (ic *ContainerEngine) ContainerCp(ctx context.Context, source, dest string, options entities.ContainerCpOptions) (*entities.ContainerCpReport, error) {
+	srcCtr, srcPath, err := ic.parseCpPath(source)
+	if err != nil {
+		return nil, err
+	}
+	destCtr, destPath, err := ic.parseCpPath(dest)
+	if err != nil {
+		return nil, err
+	}
+
+	if (srcCtr == "" && destCtr == "") || (srcCtr != "" && destCtr != "") {
+		return nil, errors.Errorf("invalid arguments %s, %s you must use just one container", source, dest)
+	}
+	if len(srcPath) == 0 || len(destPath) == 0 {
+		return nil, errors.Errorf("invalid arguments %s, %s you must specify paths", source, dest)
+	}
+
+	if srcCtr != "" {
+		err = ic.copyFromContainer(srcCtr, srcPath, destPath, options)
+	} else {
+		err = ic.copyToContainer(destCtr, source, srcPath, destPath, options)
+	}
+	return &entities.ContainerCpReport{}, err
+}
+
+// parseCpPath splits a [CONTAINER:]PATH argument of cp. The returned
+// container is empty if the path is on the local host.
+func (ic *ContainerEngine) parseCpPath(path string) (string, string, error) {
+	pathArr := strings.SplitN(path, ":", 2)
+	if len(pathArr) == 2 {
+		exists, err := containers.Exists(ic.ClientCxt, pathArr[0], false)
+		if err != nil {
+			return "", "", err
+		}
+		if exists {
+			return pathArr[0], pathArr[1], nil
+		}
+	}
+	return "", path, nil
+}
+
+// copyFromContainer copies srcPath in the container to the local destPath.
+func (ic *ContainerEngine) copyFromContainer(nameOrID, srcPath, destPath string, options entities.ContainerCpOptions) error {
+	stat, err := containers.Stat(ic.ClientCxt, nameOrID, srcPath)
+	if err != nil {
+		return err
+	}
+
+	if destPath == "-" {
+		return containers.CopyToArchive(ic.ClientCxt, nameOrID, srcPath, &options.Pause, os.Stdout)
+	}
+
+	srcInfo := archive.CopyInfo{
+		Path:   srcPath,
+		Exists: true,
+		IsDir:  stat.Mode.IsDir(),
+	}
+	// Copying the root directory always copies its contents.
+	if filepath.Clean(srcPath) == string(os.PathSeparator) {
+		srcInfo.Path = string(os.PathSeparator) + "."
+	}
+
+	// Create missing parent directories of the destination.
+	if err := os.MkdirAll(filepath.Dir(filepath.Clean(destPath)), 0755); err != nil {
+		return err
+	}
+	destInfo, err := archive.CopyInfoDestinationPath(destPath)
+	if err != nil {
+		return err
+	}
+
+	reader, writer := io.Pipe()
+	defer reader.Close()
+	go func() {
+		writer.CloseWithError(containers.CopyToArchive(ic.ClientCxt, nameOrID, srcPath, &options.Pause, writer))
+	}()
+
+	destDir, content, err := archive.PrepareArchiveCopy(reader, srcInfo, destInfo)
+	if err != nil {
+		return err
+	}
+	defer content.Close()
+
+	// The copied files are owned by the calling user.
+	tarOptions := &archive.TarOptions{
+		ChownOpts: &idtools.IDPair{UID: os.Getuid(), GID: os.Getgid()},
+	}
+	if err := archive.Untar(content, destDir, tarOptions); err != nil {
+		return errors.Wrapf(err, "error copying %q to %q", srcPath, destPath)
+	}
+	return nil
+}
+
+// copyToContainer copies the local srcPath to destPath in the container. The
+// copied files are owned by the user of the container.
+func (ic *ContainerEngine) copyToContainer(nameOrID, source, srcPath, destPath string, options entities.ContainerCpOptions) error {
+	chown := true
+
+	// A tar archive read from stdin is extracted into the destination.
+	if source == "-" {
+		return containers.CopyFromArchive(ic.ClientCxt, nameOrID, destPath, &options.Pause, &chown, nil, os.Stdin)
+	}
+
+	srcInfo, err := archive.CopyInfoSourcePath(srcPath, true)
+	if err != nil {
+		return err
+	}
+
+	if options.Extract && !srcInfo.IsDir {
+		file, err := os.Open(srcInfo.Path)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		return containers.CopyFromArchive(ic.ClientCxt, nameOrID, destPath, &options.Pause, &chown, nil, file)
+	}
+
+	destInfo := archive.CopyInfo{Path: destPath}
+	stat, err := containers.Stat(ic.ClientCxt, nameOrID, destPath)
+	switch {
+	case err == nil:
+		destInfo.Exists = true
+		destInfo.IsDir = stat.Mode.IsDir()
+	case !os.IsNotExist(errors.Cause(err)):
+		return err
+	}
+
+	content, err := archive.TarResource(srcInfo)
+	if err != nil {
+		return err
+	}
+	defer content.Close()
+
+	destDir, preparedArchive, err := archive.PrepareArchiveCopy(content, srcInfo, destInfo)
+	if err != nil {
+		return err
+	}
+	defer preparedArchive.Close()
+
+	if err := containers.CopyFromArchive(ic.ClientCxt, nameOrID, destDir, &options.Pause, &chown, nil, preparedArchive); err != nil {
+		return errors.Wrapf(err, "error copying %q to %q", srcPath, destPath)
+	}
+	return nil
+}
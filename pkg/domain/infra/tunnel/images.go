@@ -228,6 +228,10 @@ func (ir *ImageEngine) Push(ctx context.Context, source string, destination stri
 	return images.Push(ir.ClientCxt, source, destination, options)
 }
 
+func (ir *ImageEngine) RegistryInspect(ctx context.Context, name string, opts entities.ImageRegistryInspectOptions) (*entities.ImageRegistryInspectReport, error) {
+	return images.RegistryInspect(ir.ClientCxt, name, opts)
+}
+
 func (ir *ImageEngine) Save(ctx context.Context, nameOrID string, tags []string, options entities.ImageSaveOptions) error {
 	var (
 		f   *os.File
@@ -334,5 +338,5 @@ func (ir *ImageEngine) Shutdown(_ context.Context) {
 }
 
 func (ir *ImageEngine) Sign(ctx context.Context, names []string, options entities.SignOptions) (*entities.SignReport, error) {
-	return nil, errors.New("not implemented yet")
+	return images.Sign(ir.ClientCxt, names, options)
 }
@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/containers/podman/v2/libpod/define"
 	"github.com/containers/podman/v2/libpod/image"
+	"github.com/containers/podman/v2/pkg/bindings/images"
 	"github.com/containers/podman/v2/pkg/bindings/manifests"
 	"github.com/containers/podman/v2/pkg/domain/entities"
 	"github.com/pkg/errors"
@@ -21,6 +23,15 @@ func (ir *ImageEngine) ManifestCreate(ctx context.Context, names, images []strin
 	return imageID, err
 }
 
+// ManifestExists checks if a manifest list with the given name exists on the remote host
+func (ir *ImageEngine) ManifestExists(ctx context.Context, name string) (*entities.BoolReport, error) {
+	exists, err := manifests.Exists(ir.ClientCxt, name)
+	if err != nil {
+		return nil, err
+	}
+	return &entities.BoolReport{Value: exists}, nil
+}
+
 // ManifestInspect returns contents of manifest list with given name
 func (ir *ImageEngine) ManifestInspect(ctx context.Context, name string) ([]byte, error) {
 	list, err := manifests.Inspect(ir.ClientCxt, name)
@@ -66,7 +77,30 @@ func (ir *ImageEngine) ManifestAdd(ctx context.Context, opts entities.ManifestAd
 
 // ManifestAnnotate updates an entry of the manifest list
 func (ir *ImageEngine) ManifestAnnotate(ctx context.Context, names []string, opts entities.ManifestAnnotateOptions) (string, error) {
-	return "", errors.New("not implemented")
+	manifestAnnotateOpts := image.ManifestAnnotateOpts{
+		Arch:       opts.Arch,
+		Features:   opts.Features,
+		OS:         opts.OS,
+		OSFeatures: opts.OSFeatures,
+		OSVersion:  opts.OSVersion,
+		Variant:    opts.Variant,
+	}
+	if len(opts.Annotation) > 0 {
+		annotations := make(map[string]string)
+		for _, annotationSpec := range opts.Annotation {
+			spec := strings.SplitN(annotationSpec, "=", 2)
+			if len(spec) != 2 {
+				return "", errors.Errorf("no value given for annotation %q", spec[0])
+			}
+			annotations[spec[0]] = spec[1]
+		}
+		manifestAnnotateOpts.Annotation = annotations
+	}
+	updatedListID, err := manifests.Annotate(ir.ClientCxt, names[0], names[1], manifestAnnotateOpts)
+	if err != nil {
+		return updatedListID, errors.Wrapf(err, "error annotating %s of manifest list %s", names[1], names[0])
+	}
+	return fmt.Sprintf("%s: %s", updatedListID, names[1]), nil
 }
 
 // ManifestRemove removes the digest from manifest list
@@ -78,6 +112,37 @@ func (ir *ImageEngine) ManifestRemove(ctx context.Context, names []string) (stri
 	return fmt.Sprintf("%s :%s\n", updatedListID, names[1]), nil
 }
 
+// ManifestRm removes the specified manifest lists from local storage
+func (ir *ImageEngine) ManifestRm(ctx context.Context, names []string) (*entities.ImageRemoveReport, []error) {
+	var (
+		lists    []string
+		rmErrors []error
+	)
+	for _, name := range names {
+		exists, err := manifests.Exists(ir.ClientCxt, name)
+		switch {
+		case err != nil:
+			rmErrors = append(rmErrors, err)
+		case !exists:
+			rmErrors = append(rmErrors, errors.Wrapf(define.ErrNoSuchImage, "%s is not a manifest list", name))
+		default:
+			lists = append(lists, name)
+		}
+	}
+	report := &entities.ImageRemoveReport{}
+	if len(lists) > 0 {
+		removed, errs := images.BatchRemove(ir.ClientCxt, lists, entities.ImageRemoveOptions{})
+		if removed != nil {
+			report = removed
+		}
+		rmErrors = append(rmErrors, errs...)
+	}
+	if len(rmErrors) > 0 && report.ExitCode == 0 {
+		report.ExitCode = 1
+	}
+	return report, rmErrors
+}
+
 // ManifestPush pushes a manifest list or image index to the destination
 func (ir *ImageEngine) ManifestPush(ctx context.Context, names []string, opts entities.ManifestPushOptions) error {
 	_, err := manifests.Push(ir.ClientCxt, names[0], &names[1], &opts.All)
@@ -10,3 +10,7 @@ import (
 func (ic *ContainerEngine) PlayKube(ctx context.Context, path string, options entities.PlayKubeOptions) (*entities.PlayKubeReport, error) {
 	return play.Kube(ic.ClientCxt, path, options)
 }
+
+func (ic *ContainerEngine) PlayKubeDown(ctx context.Context, path string, options entities.PlayKubeDownOptions) (*entities.PlayKubeDownReport, error) {
+	return play.KubeDown(ic.ClientCxt, path)
+}
@@ -0,0 +1,59 @@
+package tunnel
+
+import (
+	"context"
+	"io"
+
+	"github.com/containers/podman/v2/pkg/bindings/secrets"
+	"github.com/containers/podman/v2/pkg/domain/entities"
+	"github.com/pkg/errors"
+)
+
+func (ic *ContainerEngine) SecretCreate(ctx context.Context, name string, reader io.Reader, options entities.SecretCreateOptions) (*entities.SecretCreateReport, error) {
+	return secrets.Create(ic.ClientCxt, name, reader, options)
+}
+
+func (ic *ContainerEngine) SecretInspect(ctx context.Context, nameOrIDs []string) ([]*entities.SecretInfoReport, []error, error) {
+	allInspect := make([]*entities.SecretInfoReport, 0, len(nameOrIDs))
+	errs := make([]error, 0, len(nameOrIDs))
+	for _, name := range nameOrIDs {
+		inspected, err := secrets.Inspect(ic.ClientCxt, name)
+		if err != nil {
+			errModel, ok := err.(entities.ErrorModel)
+			if !ok {
+				return nil, nil, err
+			}
+			if errModel.ResponseCode == 404 {
+				errs = append(errs, errors.Errorf("no such secret %s", name))
+				continue
+			}
+			return nil, nil, err
+		}
+		allInspect = append(allInspect, inspected)
+	}
+	return allInspect, errs, nil
+}
+
+func (ic *ContainerEngine) SecretList(ctx context.Context) ([]*entities.SecretInfoReport, error) {
+	return secrets.List(ic.ClientCxt)
+}
+
+func (ic *ContainerEngine) SecretRm(ctx context.Context, nameOrIDs []string, options entities.SecretRmOptions) ([]*entities.SecretRmReport, error) {
+	if options.All {
+		allSecrets, err := secrets.List(ic.ClientCxt)
+		if err != nil {
+			return nil, err
+		}
+		for _, secr := range allSecrets {
+			nameOrIDs = append(nameOrIDs, secr.ID)
+		}
+	}
+	reports := make([]*entities.SecretRmReport, 0, len(nameOrIDs))
+	for _, name := range nameOrIDs {
+		reports = append(reports, &entities.SecretRmReport{
+			Err: secrets.Remove(ic.ClientCxt, name),
+			ID:  name,
+		})
+	}
+	return reports, nil
+}
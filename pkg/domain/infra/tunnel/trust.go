@@ -2,15 +2,15 @@ package tunnel
 
 import (
 	"context"
-	"errors"
 
+	"github.com/containers/podman/v2/pkg/bindings/images"
 	"github.com/containers/podman/v2/pkg/domain/entities"
 )
 
 func (ir *ImageEngine) ShowTrust(ctx context.Context, args []string, options entities.ShowTrustOptions) (*entities.ShowTrustReport, error) {
-	return nil, errors.New("not implemented")
+	return images.ShowTrust(ir.ClientCxt, options)
 }
 
 func (ir *ImageEngine) SetTrust(ctx context.Context, args []string, options entities.SetTrustOptions) error {
-	return errors.New("not implemented")
+	return images.SetTrust(ir.ClientCxt, args[0], options)
 }
@@ -2,6 +2,7 @@ package tunnel
 
 import (
 	"context"
+	"os"
 
 	"github.com/containers/podman/v2/pkg/bindings/volumes"
 	"github.com/containers/podman/v2/pkg/domain/entities"
@@ -16,6 +17,29 @@ func (ic *ContainerEngine) VolumeCreate(ctx context.Context, opts entities.Volum
 	return &entities.IDOrNameResponse{IDOrName: response.Name}, nil
 }
 
+func (ic *ContainerEngine) VolumeExport(ctx context.Context, nameOrID string, options entities.VolumeExportOptions) error {
+	// Look up the volume first, so the output file is not truncated if it
+	// does not exist.
+	if _, err := volumes.Inspect(ic.ClientCxt, nameOrID); err != nil {
+		return err
+	}
+	output, err := os.Create(options.Output)
+	if err != nil {
+		return err
+	}
+	defer output.Close()
+	return volumes.Export(ic.ClientCxt, nameOrID, output)
+}
+
+func (ic *ContainerEngine) VolumeImport(ctx context.Context, nameOrID string, options entities.VolumeImportOptions) error {
+	input, err := os.Open(options.Input)
+	if err != nil {
+		return err
+	}
+	defer input.Close()
+	return volumes.Import(ic.ClientCxt, nameOrID, input)
+}
+
 func (ic *ContainerEngine) VolumeRm(ctx context.Context, namesOrIds []string, opts entities.VolumeRmOptions) ([]*entities.VolumeRmReport, error) {
 	if opts.All {
 		vols, err := volumes.List(ic.ClientCxt, nil)
@@ -0,0 +1,110 @@
+package secrets
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setup(t *testing.T) (*SecretsManager, map[string]string, string) {
+	testpath, err := ioutil.TempDir("", "secretsdata")
+	require.NoError(t, err)
+	manager, err := NewManager(testpath)
+	require.NoError(t, err)
+	return manager, map[string]string{"path": testpath}, testpath
+}
+
+func TestAddSecretAndLookupData(t *testing.T) {
+	manager, opts, testpath := setup(t)
+	defer os.RemoveAll(testpath)
+
+	id, err := manager.Store("mysecret", []byte("mydata"), FileDriver, opts)
+	require.NoError(t, err)
+
+	secret, data, err := manager.LookupSecretData("mysecret")
+	require.NoError(t, err)
+	assert.Equal(t, id, secret.ID)
+	assert.Equal(t, "mydata", string(data))
+
+	// lookup by partial ID
+	secret, err = manager.Lookup(id[:5])
+	require.NoError(t, err)
+	assert.Equal(t, "mysecret", secret.Name)
+
+	// a second manager on the same path sees the stored secret
+	other, err := NewManager(testpath)
+	require.NoError(t, err)
+	secret, err = other.Lookup("mysecret")
+	require.NoError(t, err)
+	assert.Equal(t, id, secret.ID)
+}
+
+func TestAddSecretDupName(t *testing.T) {
+	manager, opts, testpath := setup(t)
+	defer os.RemoveAll(testpath)
+
+	_, err := manager.Store("mysecret", []byte("mydata"), FileDriver, opts)
+	require.NoError(t, err)
+
+	_, err = manager.Store("mysecret", []byte("mydata"), FileDriver, opts)
+	assert.Equal(t, errSecretNameInUse, errors.Cause(err))
+}
+
+func TestAddSecretInvalid(t *testing.T) {
+	manager, opts, testpath := setup(t)
+	defer os.RemoveAll(testpath)
+
+	for _, name := range []string{"", "-mysecret", "mysecret.", "my/secret", "averylongsecretnamethatexceedsthesixtyfourcharacterlimitforsecrets"} {
+		_, err := manager.Store(name, []byte("mydata"), FileDriver, opts)
+		assert.Equal(t, errInvalidSecretName, errors.Cause(err), name)
+	}
+
+	_, err := manager.Store("mysecret", []byte(""), FileDriver, opts)
+	assert.Equal(t, errDataSize, errors.Cause(err))
+
+	_, err = manager.Store("mysecret", []byte("mydata"), "unknown", opts)
+	assert.Equal(t, errInvalidDriver, errors.Cause(err))
+
+	_, err = manager.Store("mysecret", []byte("mydata"), FileDriver, nil)
+	assert.Equal(t, errInvalidDriverOpt, errors.Cause(err))
+}
+
+func TestDeleteSecret(t *testing.T) {
+	manager, opts, testpath := setup(t)
+	defer os.RemoveAll(testpath)
+
+	id, err := manager.Store("mysecret", []byte("mydata"), FileDriver, opts)
+	require.NoError(t, err)
+
+	removed, err := manager.Delete("mysecret")
+	require.NoError(t, err)
+	assert.Equal(t, id, removed)
+
+	_, err = manager.Lookup("mysecret")
+	assert.Equal(t, ErrNoSuchSecret, errors.Cause(err))
+
+	_, err = manager.Delete("mysecret")
+	assert.Equal(t, ErrNoSuchSecret, errors.Cause(err))
+}
+
+func TestListSecrets(t *testing.T) {
+	manager, opts, testpath := setup(t)
+	defer os.RemoveAll(testpath)
+
+	secrets, err := manager.List()
+	require.NoError(t, err)
+	assert.Len(t, secrets, 0)
+
+	_, err = manager.Store("mysecret", []byte("mydata"), FileDriver, opts)
+	require.NoError(t, err)
+	_, err = manager.Store("mysecret2", []byte("mydata2"), FileDriver, opts)
+	require.NoError(t, err)
+
+	secrets, err = manager.List()
+	require.NoError(t, err)
+	assert.Len(t, secrets, 2)
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/containers/common/pkg/config"
 	"github.com/containers/podman/v2/libpod"
+	"github.com/containers/podman/v2/libpod/define"
 	"github.com/containers/podman/v2/libpod/image"
 	"github.com/containers/podman/v2/pkg/specgen"
 	"github.com/containers/podman/v2/pkg/util"
@@ -267,6 +268,14 @@ func createContainerOptions(ctx context.Context, rt *libpod.Runtime, s *specgen.
 		options = append(options, libpod.WithImageVolumes(vols))
 	}
 
+	if len(s.Secrets) != 0 {
+		options = append(options, libpod.WithSecrets(s.Secrets))
+	}
+
+	if len(s.EnvSecrets) != 0 {
+		options = append(options, libpod.WithEnvSecrets(s.EnvSecrets))
+	}
+
 	if s.Command != nil {
 		options = append(options, libpod.WithCommand(s.Command))
 	}
@@ -291,6 +300,17 @@ func createContainerOptions(ctx context.Context, rt *libpod.Runtime, s *specgen.
 		if s.LogConfiguration.Size > 0 {
 			options = append(options, libpod.WithMaxLogSize(s.LogConfiguration.Size))
 		}
+		if s.LogConfiguration.MaxFiles > 1 {
+			switch s.LogConfiguration.Driver {
+			case define.KubernetesLogging, define.JSONLogging, "":
+			default:
+				return nil, errors.Wrapf(define.ErrInvalidArg, "max-file is not supported by the %s log driver", s.LogConfiguration.Driver)
+			}
+			if s.LogConfiguration.Size <= 0 {
+				return nil, errors.Wrapf(define.ErrInvalidArg, "max-file requires max-size to be set")
+			}
+			options = append(options, libpod.WithLogRotation(s.LogConfiguration.MaxFiles, s.LogConfiguration.Compress))
+		}
 		if len(s.LogConfiguration.Options) > 0 && s.LogConfiguration.Options["tag"] != "" {
 			// Note: I'm really guessing here.
 			options = append(options, libpod.WithLogTag(s.LogConfiguration.Options["tag"]))
@@ -352,6 +372,17 @@ func createContainerOptions(ctx context.Context, rt *libpod.Runtime, s *specgen.
 		options = append(options, libpod.WithHealthCheck(s.ContainerHealthCheckConfig.HealthConfig))
 		logrus.Debugf("New container has a health check")
 	}
+	if s.StartupHealthConfig != nil {
+		if s.HealthConfig == nil {
+			return nil, errors.Wrapf(define.ErrInvalidArg, "a startup healthcheck requires a regular healthcheck")
+		}
+		options = append(options, libpod.WithStartupHealthCheck(s.StartupHealthConfig))
+	}
+	options = append(options, libpod.WithHealthCheckLogRetention(s.HealthMaxLogCount, s.HealthMaxLogSize))
+	if s.HealthCheckOnFailureAction == define.HealthCheckOnFailureActionInvalid {
+		return nil, errors.Wrapf(define.ErrInvalidArg, "invalid on-failure action for health check")
+	}
+	options = append(options, libpod.WithHealthCheckOnFailureAction(s.HealthCheckOnFailureAction))
 	return options, nil
 }
 
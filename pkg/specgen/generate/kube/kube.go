@@ -3,23 +3,67 @@ package kube
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/containers/buildah/pkg/parse"
+	"github.com/containers/image/v5/manifest"
+	"github.com/containers/podman/v2/libpod/define"
 	"github.com/containers/podman/v2/libpod/image"
 	ann "github.com/containers/podman/v2/pkg/annotations"
 	"github.com/containers/podman/v2/pkg/specgen"
 	"github.com/containers/podman/v2/pkg/util"
+	"github.com/ghodss/yaml"
 	spec "github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/pkg/errors"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
+// PlayLabel is set on the pods and volumes created by play kube. Its value
+// is the kind and name of the Kubernetes object they were created from, see
+// PlayLabelValue. It is used to find them again when tearing down.
+const PlayLabel = "io.podman.kube.play"
+
+// PlayLabelValue returns the value of PlayLabel for the Kubernetes object of
+// the given kind and name.
+func PlayLabelValue(kind, name string) string {
+	return kind + "/" + name
+}
+
+// KubeVolumeType describes how a volume of a Kubernetes pod is provided.
+type KubeVolumeType int
+
+const (
+	// KubeVolumeTypeBindMount is a HostPath volume, bind mounted from the
+	// host.
+	KubeVolumeTypeBindMount KubeVolumeType = iota
+	// KubeVolumeTypeNamed is a PersistentVolumeClaim, backed by a named
+	// podman volume.
+	KubeVolumeTypeNamed
+)
+
+// KubeVolume is a volume of a Kubernetes pod, resolved to what provides it
+// on the host.
+type KubeVolume struct {
+	// Type of the volume.
+	Type KubeVolumeType
+	// Source is the host path of a bind mount, or the name of a named
+	// volume.
+	Source string
+	// ReadOnly forces the volume to be mounted read only.
+	ReadOnly bool
+}
+
 func ToPodGen(ctx context.Context, podName string, podYAML *v1.PodTemplateSpec) (*specgen.PodSpecGenerator, error) {
 	p := specgen.NewPodSpecGenerator()
 	p.Name = podName
-	p.Labels = podYAML.ObjectMeta.Labels
+	p.Labels = make(map[string]string, len(podYAML.ObjectMeta.Labels))
+	for k, v := range podYAML.ObjectMeta.Labels {
+		p.Labels[k] = v
+	}
 	// TODO we only configure Process namespace. We also need to account for Host{IPC,Network,PID}
 	// which is not currently possible with pod create
 	if podYAML.Spec.ShareProcessNamespace != nil && *podYAML.Spec.ShareProcessNamespace {
@@ -44,10 +88,75 @@ func ToPodGen(ctx context.Context, podName string, podYAML *v1.PodTemplateSpec)
 	podPorts := getPodPorts(podYAML.Spec.Containers)
 	p.PortMappings = podPorts
 
+	resourceLimits, err := podResourceLimits(podYAML.ObjectMeta.Annotations)
+	if err != nil {
+		return nil, err
+	}
+	p.ResourceLimits = resourceLimits
+
 	return p, nil
 }
 
-func ToSpecGen(ctx context.Context, containerYAML v1.Container, iid string, newImage *image.Image, volumes map[string]string, podID, podName, infraID string, configMaps []v1.ConfigMap, seccompPaths *KubeSeccompPaths, restartPolicy string) (*specgen.SpecGenerator, error) {
+// podResourceLimits returns the resource limits of the pod cgroup recorded in
+// the pod annotations by generate kube, or nil if there are none.
+func podResourceLimits(annotations map[string]string) (*spec.LinuxResources, error) {
+	var (
+		resources spec.LinuxResources
+		hasLimits bool
+	)
+	prefix := define.KubePodResourceLimitsAnnotation + "/"
+	if value, ok := annotations[prefix+string(v1.ResourceCPU)]; ok {
+		cpus, err := resource.ParseQuantity(value)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid pod CPU limit %q", value)
+		}
+		if milliCPU := cpus.MilliValue(); milliCPU > 0 {
+			period, quota := util.CoresToPeriodAndQuota(float64(milliCPU) / 1000)
+			resources.CPU = &spec.LinuxCPU{
+				Quota:  &quota,
+				Period: &period,
+			}
+			hasLimits = true
+		}
+	}
+	if value, ok := annotations[prefix+"cpuset"]; ok && value != "" {
+		if resources.CPU == nil {
+			resources.CPU = &spec.LinuxCPU{}
+		}
+		resources.CPU.Cpus = value
+		hasLimits = true
+	}
+	if value, ok := annotations[prefix+string(v1.ResourceMemory)]; ok {
+		memory, err := resource.ParseQuantity(value)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid pod memory limit %q", value)
+		}
+		if limit := memory.Value(); limit > 0 {
+			resources.Memory = &spec.LinuxMemory{
+				Limit: &limit,
+			}
+			hasLimits = true
+		}
+	}
+	if value, ok := annotations[prefix+"pids"]; ok {
+		limit, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid pod PIDs limit %q", value)
+		}
+		if limit > 0 {
+			resources.Pids = &spec.LinuxPids{
+				Limit: limit,
+			}
+			hasLimits = true
+		}
+	}
+	if !hasLimits {
+		return nil, nil
+	}
+	return &resources, nil
+}
+
+func ToSpecGen(ctx context.Context, containerYAML v1.Container, iid string, newImage *image.Image, volumes map[string]*KubeVolume, podID, podName, infraID string, configMaps []v1.ConfigMap, secrets []v1.Secret, seccompPaths *KubeSeccompPaths, restartPolicy string) (*specgen.SpecGenerator, error) {
 	s := specgen.NewSpecGenerator(iid, false)
 
 	// podName should be non-empty for Deployment objects to be able to create
@@ -150,6 +259,9 @@ func ToSpecGen(ctx context.Context, containerYAML v1.Container, iid string, newI
 	envs := map[string]string{}
 	for _, env := range containerYAML.Env {
 		value := envVarValue(env, configMaps)
+		if secretValue, ok := envVarValueFromSecret(env, secrets); ok {
+			value = secretValue
+		}
 
 		envs[env.Name] = value
 	}
@@ -159,26 +271,44 @@ func ToSpecGen(ctx context.Context, containerYAML v1.Container, iid string, newI
 		for k, v := range cmEnvs {
 			envs[k] = v
 		}
+		for k, v := range envVarsFromSecret(envFrom, secrets) {
+			envs[k] = v
+		}
 	}
 	s.Env = envs
 
+	s.HealthConfig, err = ProbeToHealthConfig(containerYAML.LivenessProbe, containerYAML)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid livenessProbe of container %s", containerYAML.Name)
+	}
+
 	for _, volume := range containerYAML.VolumeMounts {
-		hostPath, exists := volumes[volume.Name]
+		kubeVolume, exists := volumes[volume.Name]
 		if !exists {
 			return nil, errors.Errorf("Volume mount %s specified for container but not configured in volumes", volume.Name)
 		}
 		if err := parse.ValidateVolumeCtrDir(volume.MountPath); err != nil {
 			return nil, errors.Wrapf(err, "error in parsing MountPath")
 		}
-		mount := spec.Mount{
-			Destination: volume.MountPath,
-			Source:      hostPath,
-			Type:        "bind",
+		var options []string
+		if volume.ReadOnly || kubeVolume.ReadOnly {
+			options = []string{"ro"}
 		}
-		if volume.ReadOnly {
-			mount.Options = []string{"ro"}
+		switch kubeVolume.Type {
+		case KubeVolumeTypeNamed:
+			s.Volumes = append(s.Volumes, &specgen.NamedVolume{
+				Name:    kubeVolume.Source,
+				Dest:    volume.MountPath,
+				Options: options,
+			})
+		default:
+			s.Mounts = append(s.Mounts, spec.Mount{
+				Destination: volume.MountPath,
+				Source:      kubeVolume.Source,
+				Type:        "bind",
+				Options:     options,
+			})
 		}
-		s.Mounts = append(s.Mounts, mount)
 	}
 
 	s.RestartPolicy = restartPolicy
@@ -186,6 +316,114 @@ func ToSpecGen(ctx context.Context, containerYAML v1.Container, iid string, newI
 	return s, nil
 }
 
+// ProbeToHealthConfig converts a Kubernetes probe of the given container into
+// a healthcheck.  Exec probes run their command; HTTP and TCP probes are run
+// with curl and nc, which must be available in the image.  A nil probe
+// results in a nil healthcheck.
+func ProbeToHealthConfig(probe *v1.Probe, containerYAML v1.Container) (*manifest.Schema2HealthConfig, error) {
+	if probe == nil {
+		return nil, nil
+	}
+
+	var test []string
+	switch {
+	case probe.Exec != nil:
+		if len(probe.Exec.Command) == 0 {
+			return nil, errors.New("exec probe has no command")
+		}
+		test = append([]string{"CMD"}, probe.Exec.Command...)
+	case probe.HTTPGet != nil:
+		port, err := probePort(probe.HTTPGet.Port, containerYAML)
+		if err != nil {
+			return nil, err
+		}
+		scheme := "http"
+		if probe.HTTPGet.Scheme != "" {
+			scheme = strings.ToLower(string(probe.HTTPGet.Scheme))
+		}
+		host := "localhost"
+		if probe.HTTPGet.Host != "" {
+			host = probe.HTTPGet.Host
+		}
+		path := probe.HTTPGet.Path
+		if path != "" && !strings.HasPrefix(path, "/") {
+			path = "/" + path
+		}
+		test = []string{"CMD-SHELL", fmt.Sprintf("curl -f %s://%s:%d%s || exit 1", scheme, host, port, path)}
+	case probe.TCPSocket != nil:
+		port, err := probePort(probe.TCPSocket.Port, containerYAML)
+		if err != nil {
+			return nil, err
+		}
+		host := "localhost"
+		if probe.TCPSocket.Host != "" {
+			host = probe.TCPSocket.Host
+		}
+		test = []string{"CMD-SHELL", fmt.Sprintf("nc -z -v %s %d || exit 1", host, port)}
+	default:
+		return nil, errors.New("probe has no exec, httpGet or tcpSocket handler")
+	}
+
+	// Apply the Kubernetes defaults for unset fields.
+	period, timeout, retries := probe.PeriodSeconds, probe.TimeoutSeconds, probe.FailureThreshold
+	if period < 1 {
+		period = 10
+	}
+	if timeout < 1 {
+		timeout = 1
+	}
+	if retries < 1 {
+		retries = 3
+	}
+	return &manifest.Schema2HealthConfig{
+		Test:        test,
+		StartPeriod: time.Duration(probe.InitialDelaySeconds) * time.Second,
+		Interval:    time.Duration(period) * time.Second,
+		Timeout:     time.Duration(timeout) * time.Second,
+		Retries:     int(retries),
+	}, nil
+}
+
+// probePort resolves the port of an HTTP or TCP probe, which may refer to a
+// named port of the container.
+func probePort(port intstr.IntOrString, containerYAML v1.Container) (int32, error) {
+	if port.Type == intstr.Int {
+		return port.IntVal, nil
+	}
+	return namedContainerPort([]v1.Container{containerYAML}, port.StrVal)
+}
+
+// StartupProbes returns the startupProbes of the containers of the Pod or
+// Deployment in the given YAML document, indexed by container name.  The
+// vendored Kubernetes API predates startupProbe, so it is read separately.
+func StartupProbes(document []byte) (map[string]*v1.Probe, error) {
+	type containers struct {
+		Containers []struct {
+			Name         string    `json:"name"`
+			StartupProbe *v1.Probe `json:"startupProbe,omitempty"`
+		} `json:"containers"`
+	}
+	var workload struct {
+		Spec struct {
+			containers
+			Template struct {
+				Spec containers `json:"spec"`
+			} `json:"template"`
+		} `json:"spec"`
+	}
+	if err := yaml.Unmarshal(document, &workload); err != nil {
+		return nil, err
+	}
+
+	probes := make(map[string]*v1.Probe)
+	for _, c := range append(workload.Spec.Containers, workload.Spec.Template.Spec.Containers...) {
+		if c.StartupProbe != nil {
+			probes[c.Name] = c.StartupProbe
+		}
+	}
+	return probes, nil
+}
+
 func setupSecurityContext(s *specgen.SpecGenerator, containerYAML v1.Container) {
 	if containerYAML.SecurityContext == nil {
 		return
@@ -282,6 +520,112 @@ func envVarValue(env v1.EnvVar, configMaps []v1.ConfigMap) string {
 	return env.Value
 }
 
+// envVarsFromSecret returns all key-value pairs as env vars from a secret that matches the envFrom setting of a container
+func envVarsFromSecret(envFrom v1.EnvFromSource, secrets []v1.Secret) map[string]string {
+	envs := map[string]string{}
+
+	if envFrom.SecretRef != nil {
+		for _, s := range secrets {
+			if envFrom.SecretRef.Name == s.Name {
+				for k, v := range secretData(s) {
+					envs[k] = v
+				}
+				break
+			}
+		}
+	}
+
+	return envs
+}
+
+// envVarValueFromSecret returns the value of an environment variable that
+// refers to a key of a secret. The boolean is false if the variable does
+// not refer to one of the given secrets.
+func envVarValueFromSecret(env v1.EnvVar, secrets []v1.Secret) (string, bool) {
+	if env.ValueFrom == nil || env.ValueFrom.SecretKeyRef == nil {
+		return "", false
+	}
+	for _, s := range secrets {
+		if env.ValueFrom.SecretKeyRef.Name == s.Name {
+			value, ok := secretData(s)[env.ValueFrom.SecretKeyRef.Key]
+			return value, ok
+		}
+	}
+
+	return "", false
+}
+
+// secretData returns the decoded data of a secret. Entries of stringData
+// take precedence over data, as in Kubernetes.
+func secretData(secret v1.Secret) map[string]string {
+	data := make(map[string]string, len(secret.Data)+len(secret.StringData))
+	for k, v := range secret.Data {
+		data[k] = string(v)
+	}
+	for k, v := range secret.StringData {
+		data[k] = v
+	}
+	return data
+}
+
+// ServicePortMappings returns the host ports published for the pod by the
+// given service. Each port of the service is published on its nodePort, or
+// on its port if no nodePort is set, and forwarded to the targetPort. No
+// ports are published if the selector of the service does not match the
+// labels of the pod.
+func ServicePortMappings(service v1.Service, podYAML *v1.PodTemplateSpec) ([]specgen.PortMapping, error) {
+	if len(service.Spec.Selector) == 0 {
+		return nil, nil
+	}
+	for k, v := range service.Spec.Selector {
+		if podYAML.ObjectMeta.Labels[k] != v {
+			return nil, nil
+		}
+	}
+
+	mappings := make([]specgen.PortMapping, 0, len(service.Spec.Ports))
+	for _, p := range service.Spec.Ports {
+		hostPort := p.NodePort
+		if hostPort == 0 {
+			hostPort = p.Port
+		}
+		containerPort := p.Port
+		switch {
+		case p.TargetPort.Type == intstr.String:
+			port, err := namedContainerPort(podYAML.Spec.Containers, p.TargetPort.StrVal)
+			if err != nil {
+				return nil, errors.Wrapf(err, "service %s", service.Name)
+			}
+			containerPort = port
+		case p.TargetPort.IntVal != 0:
+			containerPort = p.TargetPort.IntVal
+		}
+		protocol := "tcp"
+		if p.Protocol != "" {
+			protocol = strings.ToLower(string(p.Protocol))
+		}
+		mappings = append(mappings, specgen.PortMapping{
+			HostPort:      uint16(hostPort),
+			ContainerPort: uint16(containerPort),
+			Protocol:      protocol,
+		})
+	}
+	return mappings, nil
+}
+
+// namedContainerPort returns the number of the container port with the
+// given name.
+func namedContainerPort(containers []v1.Container, name string) (int32, error) {
+	for _, container := range containers {
+		for _, p := range container.Ports {
+			if p.Name == name {
+				return p.ContainerPort, nil
+			}
+		}
+	}
+	return 0, errors.Errorf("no container port named %q", name)
+}
+
 // getPodPorts converts a slice of kube container descriptions to an
 // array of portmapping
 func getPodPorts(containers []v1.Container) []specgen.PortMapping {
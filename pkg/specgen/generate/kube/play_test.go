@@ -2,10 +2,14 @@ package kube
 
 import (
 	"testing"
+	"time"
 
+	"github.com/containers/image/v5/manifest"
+	"github.com/containers/podman/v2/pkg/specgen"
 	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/api/core/v1"
 	v12 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 func TestEnvVarsFromConfigMap(t *testing.T) {
@@ -170,3 +174,339 @@ var configMapList = []v1.ConfigMap{
 		},
 	},
 }
+
+func TestEnvVarValueFromSecret(t *testing.T) {
+	secretKeyRef := func(name, key string) v1.EnvVar {
+		return v1.EnvVar{
+			Name: "FOO",
+			ValueFrom: &v1.EnvVarSource{
+				SecretKeyRef: &v1.SecretKeySelector{
+					LocalObjectReference: v1.LocalObjectReference{
+						Name: name,
+					},
+					Key: key,
+				},
+			},
+		}
+	}
+
+	value, ok := envVarValueFromSecret(secretKeyRef("foo", "myvar"), secretList)
+	assert.True(t, ok)
+	assert.Equal(t, "foo", value)
+
+	value, ok = envVarValueFromSecret(secretKeyRef("foo", "mystring"), secretList)
+	assert.True(t, ok)
+	assert.Equal(t, "plain", value)
+
+	_, ok = envVarValueFromSecret(secretKeyRef("foo", "doesnotexist"), secretList)
+	assert.False(t, ok)
+
+	_, ok = envVarValueFromSecret(secretKeyRef("doesnotexist", "myvar"), secretList)
+	assert.False(t, ok)
+
+	_, ok = envVarValueFromSecret(v1.EnvVar{Name: "FOO", Value: "bar"}, secretList)
+	assert.False(t, ok)
+}
+
+func TestEnvVarsFromSecret(t *testing.T) {
+	envFrom := v1.EnvFromSource{
+		SecretRef: &v1.SecretEnvSource{
+			LocalObjectReference: v1.LocalObjectReference{
+				Name: "foo",
+			},
+		},
+	}
+	assert.Equal(t, map[string]string{"myvar": "foo", "mystring": "plain"}, envVarsFromSecret(envFrom, secretList))
+	assert.Equal(t, map[string]string{}, envVarsFromSecret(envFrom, []v1.Secret{}))
+}
+
+func TestServicePortMappings(t *testing.T) {
+	pod := &v1.PodTemplateSpec{
+		ObjectMeta: v12.ObjectMeta{
+			Labels: map[string]string{"app": "web"},
+		},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{
+				{
+					Name: "web",
+					Ports: []v1.ContainerPort{
+						{Name: "http", ContainerPort: 80},
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		service     v1.Service
+		expectError bool
+		expected    []specgen.PortMapping
+	}{
+		{
+			"NodePort",
+			v1.Service{
+				Spec: v1.ServiceSpec{
+					Selector: map[string]string{"app": "web"},
+					Ports: []v1.ServicePort{
+						{Port: 8080, NodePort: 30080, TargetPort: intstr.FromInt(80)},
+					},
+				},
+			},
+			false,
+			[]specgen.PortMapping{{HostPort: 30080, ContainerPort: 80, Protocol: "tcp"}},
+		},
+		{
+			"PortWithoutTargetPort",
+			v1.Service{
+				Spec: v1.ServiceSpec{
+					Selector: map[string]string{"app": "web"},
+					Ports: []v1.ServicePort{
+						{Port: 53, Protocol: v1.ProtocolUDP},
+					},
+				},
+			},
+			false,
+			[]specgen.PortMapping{{HostPort: 53, ContainerPort: 53, Protocol: "udp"}},
+		},
+		{
+			"NamedTargetPort",
+			v1.Service{
+				Spec: v1.ServiceSpec{
+					Selector: map[string]string{"app": "web"},
+					Ports: []v1.ServicePort{
+						{Port: 8080, TargetPort: intstr.FromString("http")},
+					},
+				},
+			},
+			false,
+			[]specgen.PortMapping{{HostPort: 8080, ContainerPort: 80, Protocol: "tcp"}},
+		},
+		{
+			"UnknownNamedTargetPort",
+			v1.Service{
+				Spec: v1.ServiceSpec{
+					Selector: map[string]string{"app": "web"},
+					Ports: []v1.ServicePort{
+						{Port: 8080, TargetPort: intstr.FromString("doesnotexist")},
+					},
+				},
+			},
+			true,
+			nil,
+		},
+		{
+			"SelectorDoesNotMatch",
+			v1.Service{
+				Spec: v1.ServiceSpec{
+					Selector: map[string]string{"app": "db"},
+					Ports: []v1.ServicePort{
+						{Port: 8080},
+					},
+				},
+			},
+			false,
+			nil,
+		},
+		{
+			"NoSelector",
+			v1.Service{
+				Spec: v1.ServiceSpec{
+					Ports: []v1.ServicePort{
+						{Port: 8080},
+					},
+				},
+			},
+			false,
+			nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			result, err := ServicePortMappings(test.service, pod)
+			if test.expectError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			if test.expected == nil {
+				assert.Empty(t, result)
+			} else {
+				assert.Equal(t, test.expected, result)
+			}
+		})
+	}
+}
+
+func TestProbeToHealthConfig(t *testing.T) {
+	container := v1.Container{
+		Name: "web",
+		Ports: []v1.ContainerPort{
+			{Name: "http", ContainerPort: 8080},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		probe       *v1.Probe
+		expectError bool
+		expected    *manifest.Schema2HealthConfig
+	}{
+		{
+			"NilProbe",
+			nil,
+			false,
+			nil,
+		},
+		{
+			"ExecWithDefaults",
+			&v1.Probe{
+				Handler: v1.Handler{Exec: &v1.ExecAction{Command: []string{"cat", "/ready"}}},
+			},
+			false,
+			&manifest.Schema2HealthConfig{
+				Test:     []string{"CMD", "cat", "/ready"},
+				Interval: 10 * time.Second,
+				Timeout:  time.Second,
+				Retries:  3,
+			},
+		},
+		{
+			"HTTPGetNamedPort",
+			&v1.Probe{
+				Handler:             v1.Handler{HTTPGet: &v1.HTTPGetAction{Path: "healthz", Port: intstr.FromString("http")}},
+				InitialDelaySeconds: 5,
+				PeriodSeconds:       20,
+				TimeoutSeconds:      2,
+				FailureThreshold:    1,
+			},
+			false,
+			&manifest.Schema2HealthConfig{
+				Test:        []string{"CMD-SHELL", "curl -f http://localhost:8080/healthz || exit 1"},
+				StartPeriod: 5 * time.Second,
+				Interval:    20 * time.Second,
+				Timeout:     2 * time.Second,
+				Retries:     1,
+			},
+		},
+		{
+			"TCPSocket",
+			&v1.Probe{
+				Handler: v1.Handler{TCPSocket: &v1.TCPSocketAction{Port: intstr.FromInt(6379)}},
+			},
+			false,
+			&manifest.Schema2HealthConfig{
+				Test:     []string{"CMD-SHELL", "nc -z -v localhost 6379 || exit 1"},
+				Interval: 10 * time.Second,
+				Timeout:  time.Second,
+				Retries:  3,
+			},
+		},
+		{
+			"UnknownNamedPort",
+			&v1.Probe{
+				Handler: v1.Handler{TCPSocket: &v1.TCPSocketAction{Port: intstr.FromString("foo")}},
+			},
+			true,
+			nil,
+		},
+		{
+			"NoHandler",
+			&v1.Probe{},
+			true,
+			nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			result, err := ProbeToHealthConfig(test.probe, container)
+			if test.expectError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, result)
+		})
+	}
+}
+
+func TestStartupProbes(t *testing.T) {
+	pod := `
+apiVersion: v1
+kind: Pod
+spec:
+  containers:
+  - name: web
+    startupProbe:
+      exec:
+        command: ["true"]
+  - name: sidecar
+`
+	probes, err := StartupProbes([]byte(pod))
+	assert.NoError(t, err)
+	assert.Len(t, probes, 1)
+	assert.Equal(t, []string{"true"}, probes["web"].Exec.Command)
+
+	deployment := `
+apiVersion: apps/v1
+kind: Deployment
+spec:
+  template:
+    spec:
+      containers:
+      - name: web
+        startupProbe:
+          periodSeconds: 5
+          tcpSocket:
+            port: 80
+`
+	probes, err = StartupProbes([]byte(deployment))
+	assert.NoError(t, err)
+	assert.Len(t, probes, 1)
+	assert.Equal(t, int32(5), probes["web"].PeriodSeconds)
+}
+
+func TestPodResourceLimits(t *testing.T) {
+	res, err := podResourceLimits(map[string]string{"foo": "bar"})
+	assert.NoError(t, err)
+	assert.Nil(t, res)
+
+	res, err = podResourceLimits(map[string]string{
+		"io.podman.annotations.pod-limits/cpu":    "1500m",
+		"io.podman.annotations.pod-limits/cpuset": "0-1",
+		"io.podman.annotations.pod-limits/memory": "1Gi",
+		"io.podman.annotations.pod-limits/pids":   "512",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(100000), *res.CPU.Period)
+	assert.Equal(t, int64(150000), *res.CPU.Quota)
+	assert.Equal(t, "0-1", res.CPU.Cpus)
+	assert.Equal(t, int64(1024*1024*1024), *res.Memory.Limit)
+	assert.Equal(t, int64(512), res.Pids.Limit)
+
+	_, err = podResourceLimits(map[string]string{"io.podman.annotations.pod-limits/memory": "lots"})
+	assert.Error(t, err)
+	_, err = podResourceLimits(map[string]string{"io.podman.annotations.pod-limits/pids": "many"})
+	assert.Error(t, err)
+}
+
+var secretList = []v1.Secret{
+	{
+		TypeMeta: v12.TypeMeta{
+			Kind: "Secret",
+		},
+		ObjectMeta: v12.ObjectMeta{
+			Name: "foo",
+		},
+		Data: map[string][]byte{
+			"myvar": []byte("foo"),
+		},
+		StringData: map[string]string{
+			"mystring": "plain",
+		},
+	},
+}
@@ -117,6 +117,9 @@ func createPodOptions(p *specgen.PodSpecGenerator, rt *libpod.Runtime) ([]libpod
 		options = append(options, libpod.WithInfraContainerPorts(ports))
 	}
 	options = append(options, libpod.WithPodCgroups())
+	if p.ResourceLimits != nil {
+		options = append(options, libpod.WithPodResourceLimits(p.ResourceLimits))
+	}
 	if p.PodCreateCommand != nil {
 		options = append(options, libpod.WithPodCreateCommand(p.PodCreateCommand))
 	}
@@ -2,6 +2,8 @@ package specgen
 
 import (
 	"net"
+
+	spec "github.com/opencontainers/runtime-spec/specs-go"
 )
 
 // PodBasicConfig contains basic configuration options for pods.
@@ -147,6 +149,12 @@ type PodCgroupConfig struct {
 	// containers in the pod.
 	// Optional.
 	CgroupParent string `json:"cgroup_parent,omitempty"`
+	// ResourceLimits are resource limits to apply to the pod's cgroup.
+	// The limits are shared by all containers in the pod.
+	// Only CPU quota and period, the cpuset CPUs, the memory limit and
+	// the PIDs limit are supported.
+	// Optional.
+	ResourceLimits *spec.LinuxResources `json:"resource_limits,omitempty"`
 }
 
 // PodSpecGenerator describes options to create a pod
@@ -5,6 +5,7 @@ import (
 	"syscall"
 
 	"github.com/containers/image/v5/manifest"
+	"github.com/containers/podman/v2/libpod/define"
 	"github.com/containers/storage"
 	spec "github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/pkg/errors"
@@ -22,6 +23,15 @@ type LogConfig struct {
 	// Size is the maximimup size of the log file
 	// Optional.
 	Size int64 `json:"size,omitempty"`
+	// MaxFiles is the number of log files kept when the log file is
+	// rotated once it reaches Size, including the current one. Requires
+	// Size to be set.
+	// Only available if LogDriver is set to "json-file" or "k8s-file".
+	// Optional.
+	MaxFiles uint `json:"max_files,omitempty"`
+	// Compress indicates whether rotated log files are compressed.
+	// Optional.
+	Compress bool `json:"compress,omitempty"`
 	// A set of options to accompany the log driver.
 	// Optional.
 	Options map[string]string `json:"options,omitempty"`
@@ -57,6 +67,11 @@ type ContainerBasicConfig struct {
 	// container.
 	// Optional.
 	Env map[string]string `json:"env,omitempty"`
+	// EnvSecrets are secrets that will be set as environment variables in
+	// the container, mapping the name of the variable to the name or ID of
+	// the secret.
+	// Optional.
+	EnvSecrets map[string]string `json:"secret_env,omitempty"`
 	// Terminal is whether the container will create a PTY.
 	// Optional.
 	Terminal bool `json:"terminal,omitempty"`
@@ -237,6 +252,10 @@ type ContainerStorageConfig struct {
 	// If not set, the default of rslave will be used.
 	// Optional.
 	RootfsPropagation string `json:"rootfs_propagation,omitempty"`
+	// Secrets are the names or IDs of secrets that will be mounted into
+	// the container at /run/secrets/<name>.
+	// Optional.
+	Secrets []string `json:"secrets,omitempty"`
 }
 
 // ContainerSecurityConfig is a container's security features, including
@@ -444,6 +463,23 @@ type ContainerResourceConfig struct {
 // like command, retries, interval, start period, and timeout.
 type ContainerHealthCheckConfig struct {
 	HealthConfig *manifest.Schema2HealthConfig `json:"healthconfig,omitempty"`
+	// HealthCheckOnFailureAction defines how Podman reacts once the container
+	// turns unhealthy.
+	// Optional.
+	HealthCheckOnFailureAction define.HealthCheckOnFailureAction `json:"health_check_on_failure_action,omitempty"`
+	// StartupHealthConfig is a healthcheck that runs until it succeeds
+	// once, after which the regular healthcheck takes over. Requires
+	// HealthConfig to be set.
+	// Optional.
+	StartupHealthConfig *manifest.Schema2HealthConfig `json:"startupHealthConfig,omitempty"`
+	// HealthMaxLogCount is the number of healthcheck results kept in the
+	// healthcheck log. 0 uses the default of 5.
+	// Optional.
+	HealthMaxLogCount uint `json:"healthMaxLogCount,omitempty"`
+	// HealthMaxLogSize is the maximum length of the output of a healthcheck
+	// kept in the healthcheck log. 0 uses the default of 500 characters.
+	// Optional.
+	HealthMaxLogSize uint `json:"healthMaxLogSize,omitempty"`
 }
 
 // SpecGenerator creates an OCI spec and Libpod configuration options to create
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/containers/podman/v2/libpod"
+	"github.com/containers/podman/v2/libpod/define"
 	"github.com/containers/podman/v2/pkg/domain/entities"
 	"github.com/containers/podman/v2/version"
 	"github.com/pkg/errors"
@@ -122,6 +123,15 @@ func generateContainerInfo(ctr *libpod.Container, options entities.GenerateSyste
 		return nil, errors.Errorf("cannot use --new on container %q: no create command found", ctr.ID())
 	}
 
+	// A container that is killed or stopped once it turns unhealthy relies
+	// on systemd to be restarted.
+	switch ctr.HealthCheckOnFailureAction() {
+	case define.HealthCheckOnFailureActionKill, define.HealthCheckOnFailureActionStop:
+		if options.RestartPolicy == "no" {
+			logrus.Warnf("container %q has the health-on-failure action %q but the restart policy of the unit is %q: the unit will not be restarted once the container turns unhealthy", ctr.Name(), ctr.HealthCheckOnFailureAction().String(), options.RestartPolicy)
+		}
+	}
+
 	nameOrID, serviceName := containerServiceName(ctr, options)
 
 	info := containerInfo{
@@ -2,15 +2,16 @@ package terminal
 
 import (
 	"bufio"
-	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"net"
 	"os"
 	"path/filepath"
+	"strings"
 	"sync"
 
-	"github.com/sirupsen/logrus"
+	"github.com/pkg/errors"
 	"golang.org/x/crypto/ssh"
 	"golang.org/x/crypto/ssh/knownhosts"
 	"golang.org/x/crypto/ssh/terminal"
@@ -105,33 +106,58 @@ func ReadLogin() []byte {
 	return password
 }
 
-func HostKey(host string) ssh.PublicKey {
-	// parse OpenSSH known_hosts file
-	// ssh or use ssh-keyscan to get initial key
-	knownHosts := filepath.Join(homedir.HomeDir(), ".ssh", "known_hosts")
-	fd, err := os.Open(knownHosts)
-	if err != nil {
-		logrus.Error(err)
-		return nil
-	}
+// ErrUnknownHostKey is returned by the callback of HostKeyCallback if the
+// host is not listed in the known_hosts file.
+var ErrUnknownHostKey = errors.New("unknown ssh host key")
 
-	// support -H parameter for ssh-keyscan
-	hashhost := knownhosts.HashHostname(host)
+// KnownHostsFile returns the path of the OpenSSH known_hosts file of the user.
+func KnownHostsFile() string {
+	return filepath.Join(homedir.HomeDir(), ".ssh", "known_hosts")
+}
 
-	scanner := bufio.NewScanner(fd)
-	for scanner.Scan() {
-		_, hosts, key, _, _, err := ssh.ParseKnownHosts(scanner.Bytes())
-		if err != nil {
-			logrus.Errorf("Failed to parse known_hosts: %s", scanner.Text())
-			continue
+// HostKeyCallback returns a callback verifying the key presented by a ssh
+// host. If fingerprint is set, the SHA256 fingerprint of the key must match
+// it. Otherwise the key must be listed for the host in the known_hosts file.
+func HostKeyCallback(fingerprint string) ssh.HostKeyCallback {
+	return func(hostname string, remote net.Addr, key ssh.PublicKey) error {
+		if fingerprint != "" {
+			if actual := ssh.FingerprintSHA256(key); actual != fingerprint {
+				return errors.Errorf("host key verification failed: the %s key of %s has the fingerprint %s, expected %s. Someone could be eavesdropping on you (man-in-the-middle attack) or the host key has been changed", key.Type(), hostname, actual, fingerprint)
+			}
+			return nil
 		}
 
-		for _, h := range hosts {
-			if h == host || h == hashhost {
-				return key
+		knownHosts := KnownHostsFile()
+		callback, err := knownhosts.New(knownHosts)
+		if err != nil {
+			if os.IsNotExist(err) {
+				return errors.Wrapf(ErrUnknownHostKey, "host key verification failed: %s does not exist to verify %s", knownHosts, hostname)
 			}
+			return errors.Wrapf(err, "failed to parse %s", knownHosts)
 		}
+		err = callback(hostname, remote, key)
+		if keyErr, ok := err.(*knownhosts.KeyError); ok {
+			if len(keyErr.Want) > 0 {
+				return errors.Errorf("host key verification failed: the %s key of %s does not match the key in %s:%d. Someone could be eavesdropping on you (man-in-the-middle attack) or the host key has been changed", key.Type(), hostname, keyErr.Want[0].Filename, keyErr.Want[0].Line)
+			}
+			return errors.Wrapf(ErrUnknownHostKey, "host key verification failed: %s is not listed in %s", hostname, knownHosts)
+		}
+		return err
 	}
+}
 
-	return nil
+// ConfirmHostKey asks the user whether to trust the key of an unknown ssh
+// host. False is returned without asking if stdin is not a terminal.
+func ConfirmHostKey(hostname string, key ssh.PublicKey) (bool, error) {
+	if !terminal.IsTerminal(int(os.Stdin.Fd())) {
+		return false, nil
+	}
+	fmt.Fprintf(os.Stderr, "The authenticity of host %q can't be established.\n", hostname)
+	fmt.Fprintf(os.Stderr, "%s key fingerprint is %s.\n", key.Type(), ssh.FingerprintSHA256(key))
+	fmt.Fprint(os.Stderr, "Are you sure you want to continue connecting (yes/no)? ")
+	answer, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil {
+		return false, err
+	}
+	return strings.ToLower(strings.TrimSpace(answer)) == "yes", nil
 }
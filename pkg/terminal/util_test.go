@@ -0,0 +1,79 @@
+package terminal
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+func newHostKey(t *testing.T) ssh.PublicKey {
+	pub, _, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, err := ssh.NewPublicKey(pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+func TestHostKeyCallback(t *testing.T) {
+	home, err := ioutil.TempDir("", "podman-known-hosts")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(home)
+	defer os.Setenv("HOME", os.Getenv("HOME"))
+	os.Setenv("HOME", home)
+
+	key := newHostKey(t)
+	otherKey := newHostKey(t)
+	remote := &net.TCPAddr{IP: net.ParseIP("192.0.2.1"), Port: 22}
+
+	// Without known_hosts every host is unknown.
+	err = HostKeyCallback("")("server.example.com:22", remote, key)
+	if errors.Cause(err) != ErrUnknownHostKey {
+		t.Errorf("expected an unknown host key error, got %v", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(KnownHostsFile()), 0700); err != nil {
+		t.Fatal(err)
+	}
+	line := knownhosts.Line([]string{knownhosts.Normalize("server.example.com:22")}, key)
+	if err := ioutil.WriteFile(KnownHostsFile(), []byte(line+"\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name        string
+		fingerprint string
+		hostname    string
+		key         ssh.PublicKey
+		unknown     bool
+		fails       bool
+	}{
+		{"known host", "", "server.example.com:22", key, false, false},
+		{"changed key", "", "server.example.com:22", otherKey, false, true},
+		{"unknown host", "", "other.example.com:22", key, true, true},
+		{"matching fingerprint", ssh.FingerprintSHA256(otherKey), "other.example.com:22", otherKey, false, false},
+		{"mismatching fingerprint", ssh.FingerprintSHA256(otherKey), "server.example.com:22", key, false, true},
+	}
+	for _, tt := range tests {
+		err := HostKeyCallback(tt.fingerprint)(tt.hostname, remote, tt.key)
+		if (err != nil) != tt.fails {
+			t.Errorf("%s: unexpected result %v", tt.name, err)
+		}
+		if (errors.Cause(err) == ErrUnknownHostKey) != tt.unknown {
+			t.Errorf("%s: unexpected unknown host key result %v", tt.name, err)
+		}
+	}
+}
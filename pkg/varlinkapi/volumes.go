@@ -75,10 +75,14 @@ func (i *VarlinkAPI) GetVolumes(call iopodman.VarlinkCall, args []string, all bo
 	}
 	// Build the iopodman.volume struct for the return
 	for _, v := range reply {
+		mountPoint, err := v.MountPoint()
+		if err != nil {
+			return call.ReplyErrorOccurred(err.Error())
+		}
 		newVol := iopodman.Volume{
 			Driver:     v.Driver(),
 			Labels:     v.Labels(),
-			MountPoint: v.MountPoint(),
+			MountPoint: mountPoint,
 			Name:       v.Name(),
 			Options:    v.Options(),
 		}
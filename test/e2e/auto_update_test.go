@@ -0,0 +1,54 @@
+package integration
+
+import (
+	"os"
+
+	. "github.com/containers/podman/v2/test/utils"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Podman auto-update", func() {
+	var (
+		tempdir    string
+		err        error
+		podmanTest *PodmanTestIntegration
+	)
+
+	BeforeEach(func() {
+		tempdir, err = CreateTempDirInTempDir()
+		if err != nil {
+			os.Exit(1)
+		}
+		podmanTest = PodmanTestCreate(tempdir)
+		podmanTest.Setup()
+		podmanTest.SeedImages()
+	})
+
+	AfterEach(func() {
+		podmanTest.Cleanup()
+		f := CurrentGinkgoTestDescription()
+		processTestResult(f)
+	})
+
+	It("podman auto-update --dry-run with local policy", func() {
+		session := podmanTest.Podman([]string{"run", "-d", "--label", "io.containers.autoupdate=local", "--label", "PODMAN_SYSTEMD_UNIT=test.service", ALPINE, "top"})
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Equal(0))
+
+		update := podmanTest.Podman([]string{"auto-update", "--dry-run", "--format", "{{.SystemdUnit}},{{.Policy}},{{.Updated}}"})
+		update.WaitWithDefaultTimeout()
+		Expect(update.ExitCode()).To(Equal(0))
+		Expect(update.OutputToString()).To(Equal("test.service,local,false"))
+
+		// Let the image name refer to a different image.
+		tag := podmanTest.Podman([]string{"tag", BB, ALPINE})
+		tag.WaitWithDefaultTimeout()
+		Expect(tag.ExitCode()).To(Equal(0))
+
+		update = podmanTest.Podman([]string{"auto-update", "--dry-run", "--format", "{{.SystemdUnit}},{{.Policy}},{{.Updated}}"})
+		update.WaitWithDefaultTimeout()
+		Expect(update.ExitCode()).To(Equal(0))
+		Expect(update.OutputToString()).To(Equal("test.service,local,pending"))
+	})
+})
@@ -652,4 +652,180 @@ var _ = Describe("Podman checkpoint", func() {
 		// Remove exported checkpoint
 		os.Remove(fileName)
 	})
+
+	It("podman checkpoint --with-previous without pre-checkpoint", func() {
+		localRunString := getRunString([]string{ALPINE, "top"})
+		session := podmanTest.Podman(localRunString)
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Equal(0))
+		cid := session.OutputToString()
+
+		result := podmanTest.Podman([]string{"container", "checkpoint", "--with-previous", cid})
+		result.WaitWithDefaultTimeout()
+		Expect(result).To(ExitWithError())
+		Expect(result.ErrorToString()).To(ContainSubstring("has no pre-checkpoint"))
+		Expect(podmanTest.NumberOfContainersRunning()).To(Equal(1))
+	})
+
+	It("podman checkpoint with pre-checkpoints and restore from export", func() {
+		localRunString := getRunString([]string{ALPINE, "top"})
+		session := podmanTest.Podman(localRunString)
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Equal(0))
+		cid := session.OutputToString()
+		fileName := "/tmp/checkpoint-" + cid + ".tar.gz"
+		defer os.Remove(fileName)
+
+		result := podmanTest.Podman([]string{"container", "checkpoint", "-P", cid})
+		result.WaitWithDefaultTimeout()
+		Expect(result.ExitCode()).To(Equal(0))
+		Expect(podmanTest.NumberOfContainersRunning()).To(Equal(1))
+
+		result = podmanTest.Podman([]string{"container", "checkpoint", "-P", "--with-previous", cid})
+		result.WaitWithDefaultTimeout()
+		Expect(result.ExitCode()).To(Equal(0))
+		Expect(podmanTest.NumberOfContainersRunning()).To(Equal(1))
+
+		result = podmanTest.Podman([]string{"container", "checkpoint", "--with-previous", "-e", fileName, cid})
+		result.WaitWithDefaultTimeout()
+		Expect(result.ExitCode()).To(Equal(0))
+		Expect(podmanTest.NumberOfContainersRunning()).To(Equal(0))
+
+		result = podmanTest.Podman([]string{"rm", "-f", cid})
+		result.WaitWithDefaultTimeout()
+		Expect(result.ExitCode()).To(Equal(0))
+
+		result = podmanTest.Podman([]string{"container", "restore", "-i", fileName})
+		result.WaitWithDefaultTimeout()
+		Expect(result.ExitCode()).To(Equal(0))
+		Expect(podmanTest.NumberOfContainersRunning()).To(Equal(1))
+		Expect(podmanTest.GetContainerStatus()).To(ContainSubstring("Up"))
+	})
+
+	It("podman checkpoint --create-image and restore from the image", func() {
+		localRunString := getRunString([]string{"--name", "checkpoint_image_test", ALPINE, "top"})
+		session := podmanTest.Podman(localRunString)
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Equal(0))
+		cid := session.OutputToString()
+		checkpointImage := "localhost/checkpoint-image:test"
+
+		result := podmanTest.Podman([]string{"container", "checkpoint", "--create-image", checkpointImage, cid})
+		result.WaitWithDefaultTimeout()
+		Expect(result.ExitCode()).To(Equal(0))
+		Expect(podmanTest.NumberOfContainersRunning()).To(Equal(0))
+
+		result = podmanTest.Podman([]string{"image", "inspect", "--format", "{{index .Annotations \"io.podman.annotations.checkpoint.name\"}}", checkpointImage})
+		result.WaitWithDefaultTimeout()
+		Expect(result.ExitCode()).To(Equal(0))
+		Expect(result.OutputToString()).To(Equal("checkpoint_image_test"))
+
+		result = podmanTest.Podman([]string{"rm", "-f", cid})
+		result.WaitWithDefaultTimeout()
+		Expect(result.ExitCode()).To(Equal(0))
+
+		result = podmanTest.Podman([]string{"container", "restore", checkpointImage})
+		result.WaitWithDefaultTimeout()
+		Expect(result.ExitCode()).To(Equal(0))
+		Expect(result.OutputToString()).To(Equal(cid))
+		Expect(podmanTest.NumberOfContainersRunning()).To(Equal(1))
+		Expect(podmanTest.GetContainerStatus()).To(ContainSubstring("Up"))
+	})
+
+	It("podman restore of an unknown container does not pull an image", func() {
+		result := podmanTest.Podman([]string{"container", "restore", "nosuchcontainer"})
+		result.WaitWithDefaultTimeout()
+		Expect(result).To(ExitWithError())
+		Expect(result.ErrorToString()).To(ContainSubstring("no such container"))
+
+		// Local images are only restored if they are checkpoint images.
+		result = podmanTest.Podman([]string{"container", "restore", ALPINE})
+		result.WaitWithDefaultTimeout()
+		Expect(result).To(ExitWithError())
+		Expect(result.ErrorToString()).To(ContainSubstring("no such container"))
+	})
+
+	It("podman restore --ignore-rootfs of a container fails", func() {
+		localRunString := getRunString([]string{ALPINE, "top"})
+		session := podmanTest.Podman(localRunString)
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Equal(0))
+		cid := session.OutputToString()
+
+		result := podmanTest.Podman([]string{"container", "checkpoint", cid})
+		result.WaitWithDefaultTimeout()
+		Expect(result.ExitCode()).To(Equal(0))
+
+		result = podmanTest.Podman([]string{"container", "restore", "--ignore-rootfs", cid})
+		result.WaitWithDefaultTimeout()
+		Expect(result).To(ExitWithError())
+		Expect(result.ErrorToString()).To(ContainSubstring("--ignore-rootfs can only be used"))
+	})
+
+	It("podman pod checkpoint and restore", func() {
+		session, rc, podID := podmanTest.CreatePod("")
+		session.WaitWithDefaultTimeout()
+		Expect(rc).To(Equal(0))
+
+		session = podmanTest.Podman([]string{"run", "-d", "--pod", podID, "--security-opt", "seccomp=unconfined", ALPINE, "top"})
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Equal(0))
+
+		result := podmanTest.Podman([]string{"pod", "checkpoint", podID})
+		result.WaitWithDefaultTimeout()
+		Expect(result.ExitCode()).To(Equal(0))
+		Expect(result.OutputToString()).To(Equal(podID))
+
+		result = podmanTest.Podman([]string{"pod", "inspect", "--format", "{{.State}}", podID})
+		result.WaitWithDefaultTimeout()
+		Expect(result.ExitCode()).To(Equal(0))
+		Expect(result.OutputToString()).To(Equal("Exited"))
+
+		result = podmanTest.Podman([]string{"pod", "restore", podID})
+		result.WaitWithDefaultTimeout()
+		Expect(result.ExitCode()).To(Equal(0))
+
+		result = podmanTest.Podman([]string{"pod", "inspect", "--format", "{{.State}}", podID})
+		result.WaitWithDefaultTimeout()
+		Expect(result.ExitCode()).To(Equal(0))
+		Expect(result.OutputToString()).To(Equal("Running"))
+	})
+
+	It("podman pod checkpoint --export and restore --import", func() {
+		session, rc, podID := podmanTest.CreatePod("")
+		session.WaitWithDefaultTimeout()
+		Expect(rc).To(Equal(0))
+
+		session = podmanTest.Podman([]string{"run", "-d", "--pod", podID, "--security-opt", "seccomp=unconfined", ALPINE, "top"})
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Equal(0))
+		cid := session.OutputToString()
+
+		fileName := "/tmp/checkpoint-" + podID + ".tar"
+		defer os.Remove(fileName)
+
+		result := podmanTest.Podman([]string{"pod", "checkpoint", "-e", fileName, podID})
+		result.WaitWithDefaultTimeout()
+		Expect(result.ExitCode()).To(Equal(0))
+
+		result = podmanTest.Podman([]string{"pod", "rm", "-f", podID})
+		result.WaitWithDefaultTimeout()
+		Expect(result.ExitCode()).To(Equal(0))
+		Expect(podmanTest.NumberOfContainers()).To(Equal(0))
+
+		result = podmanTest.Podman([]string{"pod", "restore", "-i", fileName})
+		result.WaitWithDefaultTimeout()
+		Expect(result.ExitCode()).To(Equal(0))
+		Expect(result.OutputToString()).To(Equal(podID))
+
+		result = podmanTest.Podman([]string{"pod", "inspect", "--format", "{{.State}}", podID})
+		result.WaitWithDefaultTimeout()
+		Expect(result.ExitCode()).To(Equal(0))
+		Expect(result.OutputToString()).To(Equal("Running"))
+
+		result = podmanTest.Podman([]string{"inspect", "--format", "{{.Pod}}", cid})
+		result.WaitWithDefaultTimeout()
+		Expect(result.ExitCode()).To(Equal(0))
+		Expect(result.OutputToString()).To(Equal(podID))
+	})
 })
@@ -1,6 +1,7 @@
 package integration
 
 import (
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"strconv"
@@ -441,6 +442,51 @@ var _ = Describe("Podman generate kube", func() {
 		Expect(inspect.OutputToString()).To(ContainSubstring(vol1))
 	})
 
+	It("podman generate kube with named volume", func() {
+		volName := "test-vol"
+		ctrNameInKubePod := "test1-test-ctr"
+
+		vol := podmanTest.Podman([]string{"volume", "create", "--opt", "o=nodev", volName})
+		vol.WaitWithDefaultTimeout()
+		Expect(vol.ExitCode()).To(Equal(0))
+
+		session := podmanTest.Podman([]string{"run", "-d", "--pod", "new:test1", "--name", "test-ctr", "-v", volName + ":/volume", ALPINE, "top"})
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Equal(0))
+
+		outputFile := filepath.Join(podmanTest.RunRoot, "pod.yaml")
+		kube := podmanTest.Podman([]string{"generate", "kube", "test1", "-f", outputFile})
+		kube.WaitWithDefaultTimeout()
+		Expect(kube.ExitCode()).To(Equal(0))
+
+		content, err := ioutil.ReadFile(outputFile)
+		Expect(err).To(BeNil())
+		Expect(string(content)).To(ContainSubstring("kind: PersistentVolumeClaim"))
+		Expect(string(content)).To(ContainSubstring("claimName: " + volName))
+
+		rm := podmanTest.Podman([]string{"pod", "rm", "-f", "test1"})
+		rm.WaitWithDefaultTimeout()
+		Expect(rm.ExitCode()).To(Equal(0))
+
+		rmVol := podmanTest.Podman([]string{"volume", "rm", volName})
+		rmVol.WaitWithDefaultTimeout()
+		Expect(rmVol.ExitCode()).To(Equal(0))
+
+		play := podmanTest.Podman([]string{"play", "kube", outputFile})
+		play.WaitWithDefaultTimeout()
+		Expect(play.ExitCode()).To(Equal(0))
+
+		inspect := podmanTest.Podman([]string{"volume", "inspect", volName, "--format", "{{ .Options }}"})
+		inspect.WaitWithDefaultTimeout()
+		Expect(inspect.ExitCode()).To(Equal(0))
+		Expect(inspect.OutputToString()).To(ContainSubstring("o:nodev"))
+
+		inspect = podmanTest.Podman([]string{"inspect", ctrNameInKubePod, "--format", "{{ range .Mounts }}{{ .Name }}{{ end }}"})
+		inspect.WaitWithDefaultTimeout()
+		Expect(inspect.ExitCode()).To(Equal(0))
+		Expect(inspect.OutputToString()).To(Equal(volName))
+	})
+
 	It("podman generate kube sharing pid namespace", func() {
 		podName := "test"
 		podSession := podmanTest.Podman([]string{"pod", "create", "--name", podName, "--share", "pid"})
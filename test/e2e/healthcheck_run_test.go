@@ -203,4 +203,121 @@ var _ = Describe("Podman healthcheck run", func() {
 		inspect = podmanTest.InspectContainer("hc")
 		Expect(inspect[0].State.Healthcheck.Status).To(Equal("healthy"))
 	})
+
+	It("podman healthcheck with invalid --health-on-failure action", func() {
+		session := podmanTest.Podman([]string{"run", "-dt", "--name", "hc", "--health-on-failure", "foo", "--health-cmd", "ls /foo || exit 1", ALPINE, "top"})
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Not(Equal(0)))
+		Expect(session.ErrorToString()).To(ContainSubstring("invalid on-failure action"))
+	})
+
+	It("podman healthcheck --health-on-failure=stop", func() {
+		session := podmanTest.Podman([]string{"run", "-dt", "--name", "hc", "--health-retries", "1", "--health-on-failure", "stop", "--health-cmd", "ls /foo || exit 1", ALPINE, "top"})
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Equal(0))
+
+		inspect := podmanTest.InspectContainer("hc")
+		Expect(inspect[0].Config.HealthcheckOnFailureAction).To(Equal("stop"))
+
+		hc := podmanTest.Podman([]string{"healthcheck", "run", "hc"})
+		hc.WaitWithDefaultTimeout()
+		Expect(hc.ExitCode()).To(Equal(1))
+
+		inspect = podmanTest.InspectContainer("hc")
+		Expect(inspect[0].State.Healthcheck.Status).To(Equal("unhealthy"))
+		Expect(inspect[0].State.Status).To(Equal("exited"))
+	})
+
+	It("podman healthcheck --health-on-failure=restart", func() {
+		session := podmanTest.Podman([]string{"run", "-dt", "--name", "hc", "--health-retries", "1", "--health-on-failure", "restart", "--health-cmd", "ls /foo || exit 1", ALPINE, "top"})
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Equal(0))
+
+		startedAt := podmanTest.InspectContainer("hc")[0].State.StartedAt
+
+		hc := podmanTest.Podman([]string{"healthcheck", "run", "hc"})
+		hc.WaitWithDefaultTimeout()
+		Expect(hc.ExitCode()).To(Equal(1))
+
+		inspect := podmanTest.InspectContainer("hc")
+		Expect(inspect[0].State.Status).To(Equal("running"))
+		Expect(inspect[0].State.StartedAt).To(BeTemporally(">", startedAt))
+		Expect(inspect[0].State.Healthcheck.Status).To(Equal("starting"))
+		Expect(inspect[0].State.Healthcheck.FailingStreak).To(Equal(0))
+	})
+
+	It("podman healthcheck startup healthcheck hands off to regular healthcheck", func() {
+		session := podmanTest.Podman([]string{"run", "-dt", "--name", "hc", "--health-startup-cmd", "ls /foo || exit 1", "--health-startup-retries", "3", "--health-cmd", "ls /bar || exit 1", ALPINE, "top"})
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Equal(0))
+
+		inspect := podmanTest.InspectContainer("hc")
+		Expect(inspect[0].Config.StartupHealthCheck.Test).To(Equal([]string{"CMD-SHELL", "ls /foo || exit 1"}))
+
+		hc := podmanTest.Podman([]string{"healthcheck", "run", "hc"})
+		hc.WaitWithDefaultTimeout()
+		Expect(hc.ExitCode()).To(Equal(1))
+
+		inspect = podmanTest.InspectContainer("hc")
+		Expect(inspect[0].State.Healthcheck.Status).To(Equal("starting"))
+
+		foo := podmanTest.Podman([]string{"exec", "hc", "touch", "/foo"})
+		foo.WaitWithDefaultTimeout()
+		Expect(foo.ExitCode()).To(BeZero())
+
+		// The startup healthcheck succeeds ...
+		hc = podmanTest.Podman([]string{"healthcheck", "run", "hc"})
+		hc.WaitWithDefaultTimeout()
+		Expect(hc.ExitCode()).To(Equal(0))
+
+		// ... and the regular one takes over.
+		hc = podmanTest.Podman([]string{"healthcheck", "run", "hc"})
+		hc.WaitWithDefaultTimeout()
+		Expect(hc.ExitCode()).To(Equal(1))
+
+		bar := podmanTest.Podman([]string{"exec", "hc", "touch", "/bar"})
+		bar.WaitWithDefaultTimeout()
+		Expect(bar.ExitCode()).To(BeZero())
+
+		hc = podmanTest.Podman([]string{"healthcheck", "run", "hc"})
+		hc.WaitWithDefaultTimeout()
+		Expect(hc.ExitCode()).To(Equal(0))
+
+		inspect = podmanTest.InspectContainer("hc")
+		Expect(inspect[0].State.Healthcheck.Status).To(Equal("healthy"))
+	})
+
+	It("podman healthcheck failing startup healthcheck restarts the container", func() {
+		session := podmanTest.Podman([]string{"run", "-dt", "--name", "hc", "--health-startup-cmd", "ls /foo || exit 1", "--health-startup-retries", "1", "--health-cmd", "ls || exit 1", ALPINE, "top"})
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Equal(0))
+
+		startedAt := podmanTest.InspectContainer("hc")[0].State.StartedAt
+
+		hc := podmanTest.Podman([]string{"healthcheck", "run", "hc"})
+		hc.WaitWithDefaultTimeout()
+		Expect(hc.ExitCode()).To(Equal(1))
+
+		inspect := podmanTest.InspectContainer("hc")
+		Expect(inspect[0].State.Status).To(Equal("running"))
+		Expect(inspect[0].State.StartedAt).To(BeTemporally(">", startedAt))
+	})
+
+	It("podman healthcheck --health-max-log-count", func() {
+		session := podmanTest.Podman([]string{"run", "-dt", "--name", "hc", "--health-max-log-count", "2", "--health-max-log-size", "3", "--health-cmd", "echo hello", ALPINE, "top"})
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Equal(0))
+
+		for i := 0; i < 3; i++ {
+			hc := podmanTest.Podman([]string{"healthcheck", "run", "hc"})
+			hc.WaitWithDefaultTimeout()
+			Expect(hc.ExitCode()).To(Equal(0))
+		}
+
+		inspect := podmanTest.InspectContainer("hc")
+		Expect(inspect[0].Config.HealthMaxLogCount).To(Equal(uint(2)))
+		Expect(inspect[0].Config.HealthMaxLogSize).To(Equal(uint(3)))
+		Expect(len(inspect[0].State.Healthcheck.Log)).To(Equal(2))
+		Expect(inspect[0].State.Healthcheck.Log[0].Output).To(Equal("hel"))
+	})
 })
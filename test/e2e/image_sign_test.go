@@ -19,7 +19,6 @@ var _ = Describe("Podman image sign", func() {
 	)
 
 	BeforeEach(func() {
-		SkipIfRemote("podman-remote image sign is not supported")
 		tempdir, err = CreateTempDirInTempDir()
 		if err != nil {
 			os.Exit(1)
@@ -46,6 +45,7 @@ var _ = Describe("Podman image sign", func() {
 	})
 
 	It("podman sign image", func() {
+		SkipIfRemote("the signatures of podman-remote are stored on the server")
 		cmd := exec.Command("gpg", "--import", "sign/secret-key.asc")
 		err := cmd.Run()
 		Expect(err).To(BeNil())
@@ -58,4 +58,14 @@ var _ = Describe("Podman image sign", func() {
 		_, err = os.Stat(filepath.Join(sigDir, "library"))
 		Expect(err).To(BeNil())
 	})
+
+	It("podman-remote sign image with --directory fails", func() {
+		if !IsRemote() {
+			Skip("podman stores the signatures in --directory")
+		}
+		session := podmanTest.Podman([]string{"image", "sign", "--directory", podmanTest.TempDir, "--sign-by", "foo@bar.com", "docker://library/alpine"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).To(ExitWithError())
+		Expect(session.ErrorToString()).To(ContainSubstring("--directory is not supported"))
+	})
 })
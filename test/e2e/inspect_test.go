@@ -428,4 +428,28 @@ var _ = Describe("Podman inspect", func() {
 		Expect(inspect).To(ExitWithError())
 	})
 
+	It("podman image inspect --remote-registry", func() {
+		inspect := podmanTest.Podman([]string{"image", "inspect", "--remote-registry", ALPINE})
+		inspect.WaitWithDefaultTimeout()
+		Expect(inspect.ExitCode()).To(Equal(0))
+		Expect(inspect.IsJSONOutputValid()).To(BeTrue())
+
+		format := podmanTest.Podman([]string{"image", "inspect", "--remote-registry", "--format", "{{.Name}} {{.Descriptor.Digest}}", ALPINE})
+		format.WaitWithDefaultTimeout()
+		Expect(format.ExitCode()).To(Equal(0))
+		Expect(format.OutputToString()).To(HavePrefix(ALPINE + " sha256:"))
+	})
+
+	It("podman image inspect --remote-registry on a non-existent image should fail", func() {
+		inspect := podmanTest.Podman([]string{"image", "inspect", "--remote-registry", "quay.io/libpod/nonesuch:latest"})
+		inspect.WaitWithDefaultTimeout()
+		Expect(inspect).To(ExitWithError())
+	})
+
+	It("podman image inspect registry flags require --remote-registry", func() {
+		inspect := podmanTest.Podman([]string{"image", "inspect", "--tls-verify=false", ALPINE})
+		inspect.WaitWithDefaultTimeout()
+		Expect(inspect).To(ExitWithError())
+	})
+
 })
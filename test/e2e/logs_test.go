@@ -2,8 +2,10 @@ package integration
 
 import (
 	"fmt"
+	"io/ioutil"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"strings"
 
 	. "github.com/containers/podman/v2/test/utils"
@@ -120,6 +122,67 @@ var _ = Describe("Podman logs", func() {
 		Expect(len(results.OutputToStringArray())).To(Equal(3))
 	})
 
+	It("until time 2017-08-07", func() {
+		logc := podmanTest.Podman([]string{"run", "-dt", ALPINE, "sh", "-c", "echo podman; echo podman; echo podman"})
+		logc.WaitWithDefaultTimeout()
+		Expect(logc).To(Exit(0))
+		cid := logc.OutputToString()
+
+		results := podmanTest.Podman([]string{"logs", "--until", "2017-08-07T10:10:09.056611202-04:00", cid})
+		results.WaitWithDefaultTimeout()
+		Expect(results).To(Exit(0))
+		Expect(len(results.OutputToStringArray())).To(Equal(0))
+	})
+
+	It("until duration 10m in the future", func() {
+		logc := podmanTest.Podman([]string{"run", "-dt", ALPINE, "sh", "-c", "echo podman; echo podman; echo podman"})
+		logc.WaitWithDefaultTimeout()
+		Expect(logc).To(Exit(0))
+		cid := logc.OutputToString()
+
+		results := podmanTest.Podman([]string{"logs", "--until=-10m", cid})
+		results.WaitWithDefaultTimeout()
+		Expect(results).To(Exit(0))
+		Expect(len(results.OutputToStringArray())).To(Equal(3))
+	})
+
+	It("follow stops at until time", func() {
+		logc := podmanTest.Podman([]string{"run", "-d", ALPINE, "sh", "-c", "while true; do echo podman; sleep 1; done"})
+		logc.WaitWithDefaultTimeout()
+		Expect(logc).To(Exit(0))
+		cid := logc.OutputToString()
+
+		results := podmanTest.Podman([]string{"logs", "-f", "--until=-5s", cid})
+		results.WaitWithDefaultTimeout()
+		Expect(results).To(Exit(0))
+		Expect(len(results.OutputToStringArray())).To(BeNumerically(">", 0))
+	})
+
+	It("stream stdout and stderr", func() {
+		logc := podmanTest.Podman([]string{"run", "-d", ALPINE, "sh", "-c", "echo out; echo err >&2"})
+		logc.WaitWithDefaultTimeout()
+		Expect(logc).To(Exit(0))
+		cid := logc.OutputToString()
+
+		wait := podmanTest.Podman([]string{"wait", cid})
+		wait.WaitWithDefaultTimeout()
+		Expect(wait).To(Exit(0))
+
+		results := podmanTest.Podman([]string{"logs", "--stream", "stdout", cid})
+		results.WaitWithDefaultTimeout()
+		Expect(results).To(Exit(0))
+		Expect(results.OutputToStringArray()).To(Equal([]string{"out"}))
+
+		results = podmanTest.Podman([]string{"logs", "--stream", "stderr", cid})
+		results.WaitWithDefaultTimeout()
+		Expect(results).To(Exit(0))
+		Expect(results.OutputToStringArray()).To(Equal([]string{"err"}))
+
+		results = podmanTest.Podman([]string{"logs", "--stream", "stdin", cid})
+		results.WaitWithDefaultTimeout()
+		Expect(results).To(ExitWithError())
+	})
+
 	It("latest and container name should fail", func() {
 		results := podmanTest.Podman([]string{"logs", "-l", "foobar"})
 		results.WaitWithDefaultTimeout()
@@ -338,6 +401,134 @@ var _ = Describe("Podman logs", func() {
 		Expect(results.OutputToString()).To(Equal("podman podman podman"))
 	})
 
+	It("using container with container log-size and max-file", func() {
+		// The container writes five batches of 20 lines, each larger than
+		// max-size, and waits for the test to sync the container in between.
+		syncDir := filepath.Join(podmanTest.TempDir, "sync")
+		err := os.Mkdir(syncDir, 0755)
+		Expect(err).To(BeNil())
+		script := `for b in 1 2 3 4 5; do for i in $(seq 1 20); do echo podman podman podman $(( (b-1)*20 + i )); done; while [ ! -f /sync/go$b ]; do sleep 0.1; done; done`
+		logc := podmanTest.Podman([]string{"create", "--log-opt=max-size=1k", "--log-opt=max-file=6", "-v", syncDir + ":/sync:z", ALPINE, "sh", "-c", script})
+		logc.WaitWithDefaultTimeout()
+		Expect(logc).To(Exit(0))
+		cid := logc.OutputToString()
+
+		inspect := podmanTest.Podman([]string{"inspect", "--format", "{{.LogPath}} {{.HostConfig.LogConfig.Config}}", cid})
+		inspect.WaitWithDefaultTimeout()
+		Expect(inspect).To(Exit(0))
+		Expect(inspect.OutputToString()).To(ContainSubstring("max-file:6"))
+		logPath := strings.Fields(inspect.OutputToString())[0]
+
+		start := podmanTest.Podman([]string{"start", cid})
+		start.WaitWithDefaultTimeout()
+		Expect(start).To(Exit(0))
+
+		for b := 1; b <= 5; b++ {
+			lastLine := fmt.Sprintf("podman podman podman %d\n", b*20)
+			Eventually(func() string {
+				content, _ := ioutil.ReadFile(logPath)
+				return string(content)
+			}, defaultWaitTimeout, 0.1).Should(ContainSubstring(lastLine))
+
+			// Syncing the container rotates the log file.
+			ps := podmanTest.Podman([]string{"ps", "-a"})
+			ps.WaitWithDefaultTimeout()
+			Expect(ps).To(Exit(0))
+
+			f, err := os.Create(filepath.Join(syncDir, fmt.Sprintf("go%d", b)))
+			Expect(err).To(BeNil())
+			f.Close()
+		}
+
+		wait := podmanTest.Podman([]string{"wait", cid})
+		wait.WaitWithDefaultTimeout()
+		Expect(wait).To(Exit(0))
+
+		_, err = os.Stat(logPath + ".5")
+		Expect(err).To(BeNil())
+
+		results := podmanTest.Podman([]string{"logs", cid})
+		results.WaitWithDefaultTimeout()
+		Expect(results).To(Exit(0))
+		Expect(len(results.OutputToStringArray())).To(Equal(100))
+
+		results = podmanTest.Podman([]string{"logs", "--tail", "2", cid})
+		results.WaitWithDefaultTimeout()
+		Expect(results).To(Exit(0))
+		Expect(results.OutputToStringArray()).To(Equal([]string{"podman podman podman 99", "podman podman podman 100"}))
+	})
+
+	It("using container with container log-size and max-file on an exited container", func() {
+		// The container writes more than max-size, but less than conmon
+		// truncates at, and exits before its log is rotated.
+		logc := podmanTest.Podman([]string{"run", "-d", "--log-opt=max-size=1k", "--log-opt=max-file=3", ALPINE, "sh", "-c", "for i in $(seq 1 30); do echo podman podman podman $i; done"})
+		logc.WaitWithDefaultTimeout()
+		Expect(logc).To(Exit(0))
+		cid := logc.OutputToString()
+
+		inspect := podmanTest.Podman([]string{"inspect", "--format", "{{.LogPath}}", cid})
+		inspect.WaitWithDefaultTimeout()
+		Expect(inspect).To(Exit(0))
+		logPath := inspect.OutputToString()
+
+		// Syncing the exited container rotates the log file.
+		wait := podmanTest.Podman([]string{"wait", cid})
+		wait.WaitWithDefaultTimeout()
+		Expect(wait).To(Exit(0))
+		ps := podmanTest.Podman([]string{"ps", "-a"})
+		ps.WaitWithDefaultTimeout()
+		Expect(ps).To(Exit(0))
+
+		_, err := os.Stat(logPath + ".1")
+		Expect(err).To(BeNil())
+		info, err := os.Stat(logPath)
+		Expect(err).To(BeNil())
+		Expect(info.Size()).To(BeZero())
+
+		results := podmanTest.Podman([]string{"logs", cid})
+		results.WaitWithDefaultTimeout()
+		Expect(results).To(Exit(0))
+		Expect(len(results.OutputToStringArray())).To(Equal(30))
+
+		results = podmanTest.Podman([]string{"logs", "--tail", "2", cid})
+		results.WaitWithDefaultTimeout()
+		Expect(results).To(Exit(0))
+		Expect(results.OutputToStringArray()).To(Equal([]string{"podman podman podman 29", "podman podman podman 30"}))
+	})
+
+	It("using container with container log-size and max-file caps the log without podman calls", func() {
+		syncDir := filepath.Join(podmanTest.TempDir, "sync")
+		err := os.Mkdir(syncDir, 0755)
+		Expect(err).To(BeNil())
+		logc := podmanTest.Podman([]string{"create", "--log-opt=max-size=1k", "--log-opt=max-file=3", "-v", syncDir + ":/sync:z", ALPINE, "sh", "-c", "for i in $(seq 1 1000); do echo podman podman podman $i; done; touch /sync/done; sleep 1000"})
+		logc.WaitWithDefaultTimeout()
+		Expect(logc).To(Exit(0))
+		cid := logc.OutputToString()
+
+		inspect := podmanTest.Podman([]string{"inspect", "--format", "{{.LogPath}}", cid})
+		inspect.WaitWithDefaultTimeout()
+		Expect(inspect).To(Exit(0))
+		logPath := inspect.OutputToString()
+
+		start := podmanTest.Podman([]string{"start", cid})
+		start.WaitWithDefaultTimeout()
+		Expect(start).To(Exit(0))
+
+		// No podman command syncs the container while it writes far more
+		// than max-size, so conmon has to cap the log file.
+		waitForFile(filepath.Join(syncDir, "done"))
+		info, err := os.Stat(logPath)
+		Expect(err).To(BeNil())
+		Expect(info.Size()).To(BeNumerically("<=", 2*1024))
+	})
+
+	It("podman run with max-file but without max-size fails", func() {
+		logc := podmanTest.Podman([]string{"run", "--log-opt=max-file=3", ALPINE, "true"})
+		logc.WaitWithDefaultTimeout()
+		Expect(logc).To(ExitWithError())
+		Expect(logc.ErrorToString()).To(ContainSubstring("max-file requires max-size"))
+	})
+
 	It("Make sure logs match expected length", func() {
 		logc := podmanTest.Podman([]string{"run", "-t", "--name", "test", ALPINE, "sh", "-c", "echo 1; echo 2"})
 		logc.WaitWithDefaultTimeout()
@@ -356,3 +547,11 @@ var _ = Describe("Podman logs", func() {
 		Expect(outlines[1]).To(Equal("2\r"))
 	})
 })
+
+// waitForFile waits until the file at path exists.
+func waitForFile(path string) {
+	Eventually(func() error {
+		_, err := os.Stat(path)
+		return err
+	}, defaultWaitTimeout, 0.1).Should(Succeed())
+}
@@ -26,6 +26,117 @@ spec:
   hostname: unknown
 `
 
+var multiDocYaml = `
+apiVersion: v1
+kind: PersistentVolumeClaim
+metadata:
+  name: multidocvol
+spec:
+  accessModes:
+  - ReadWriteOnce
+  resources:
+    requests:
+      storage: 1Gi
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: multidoccm
+data:
+  FOO: foo
+---
+apiVersion: v1
+kind: Secret
+metadata:
+  name: multidocsecret
+stringData:
+  BAR: bar
+---
+apiVersion: v1
+kind: Pod
+metadata:
+  name: multidocpod
+spec:
+  containers:
+  - name: ctr
+    image: ` + ALPINE + `
+    command:
+    - top
+    env:
+    - name: FOO
+      valueFrom:
+        configMapKeyRef:
+          name: multidoccm
+          key: FOO
+    - name: BAR
+      valueFrom:
+        secretKeyRef:
+          name: multidocsecret
+          key: BAR
+    volumeMounts:
+    - name: data
+      mountPath: /data
+  volumes:
+  - name: data
+    persistentVolumeClaim:
+      claimName: multidocvol
+`
+
+var probesYaml = `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: probespod
+spec:
+  containers:
+  - name: ctr
+    image: ` + ALPINE + `
+    command:
+    - top
+    livenessProbe:
+      exec:
+        command: ["cat", "/tmp/healthy"]
+      periodSeconds: 20
+      failureThreshold: 2
+    startupProbe:
+      exec:
+        command: ["cat", "/tmp/started"]
+      periodSeconds: 5
+`
+
+var replicatedServiceYaml = `
+apiVersion: v1
+kind: Service
+metadata:
+  name: replsvc
+spec:
+  selector:
+    app: repl
+  ports:
+  - port: 8080
+    targetPort: 80
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: repldeploy
+spec:
+  replicas: 2
+  selector:
+    matchLabels:
+      app: repl
+  template:
+    metadata:
+      labels:
+        app: repl
+    spec:
+      containers:
+      - name: ctr
+        image: ` + ALPINE + `
+        command:
+        - top
+`
+
 var configMapYamlTemplate = `
 apiVersion: v1
 kind: ConfigMap
@@ -1497,4 +1608,97 @@ MemoryReservation: {{ .HostConfig.MemoryReservation }}`})
 		Expect(inspect.ExitCode()).To(Equal(0))
 		Expect(inspect.OutputToString()).To(Equal("false"))
 	})
+
+	It("podman play kube --down removes the pod", func() {
+		pod := getPod()
+		err := generateKubeYaml("pod", pod, kubeYaml)
+		Expect(err).To(BeNil())
+
+		kube := podmanTest.Podman([]string{"play", "kube", kubeYaml})
+		kube.WaitWithDefaultTimeout()
+		Expect(kube.ExitCode()).To(Equal(0))
+
+		down := podmanTest.Podman([]string{"play", "kube", "--down", kubeYaml})
+		down.WaitWithDefaultTimeout()
+		Expect(down.ExitCode()).To(Equal(0))
+
+		ps := podmanTest.Podman([]string{"pod", "ps", "-q"})
+		ps.WaitWithDefaultTimeout()
+		Expect(ps.ExitCode()).To(Equal(0))
+		Expect(len(ps.OutputToStringArray())).To(Equal(0))
+	})
+
+	It("podman play kube with multiple documents", func() {
+		err := writeYaml(multiDocYaml, kubeYaml)
+		Expect(err).To(BeNil())
+
+		kube := podmanTest.Podman([]string{"play", "kube", kubeYaml})
+		kube.WaitWithDefaultTimeout()
+		Expect(kube.ExitCode()).To(Equal(0))
+
+		inspect := podmanTest.Podman([]string{"inspect", "multidocpod-ctr", "--format", "'{{ .Config.Env }}'"})
+		inspect.WaitWithDefaultTimeout()
+		Expect(inspect.ExitCode()).To(Equal(0))
+		Expect(inspect.OutputToString()).To(ContainSubstring("FOO=foo"))
+		Expect(inspect.OutputToString()).To(ContainSubstring("BAR=bar"))
+
+		inspect = podmanTest.Podman([]string{"inspect", "multidocpod-ctr", "--format", "{{ range .Mounts }}{{ .Name }}{{ end }}"})
+		inspect.WaitWithDefaultTimeout()
+		Expect(inspect.ExitCode()).To(Equal(0))
+		Expect(inspect.OutputToString()).To(Equal("multidocvol"))
+
+		down := podmanTest.Podman([]string{"play", "kube", "--down", kubeYaml})
+		down.WaitWithDefaultTimeout()
+		Expect(down.ExitCode()).To(Equal(0))
+
+		vols := podmanTest.Podman([]string{"volume", "ls", "-q"})
+		vols.WaitWithDefaultTimeout()
+		Expect(vols.ExitCode()).To(Equal(0))
+		Expect(vols.OutputToString()).To(Not(ContainSubstring("multidocvol")))
+	})
+
+	It("podman play kube with a Service and a Deployment with replicas", func() {
+		err := writeYaml(replicatedServiceYaml, kubeYaml)
+		Expect(err).To(BeNil())
+
+		kube := podmanTest.Podman([]string{"play", "kube", kubeYaml})
+		kube.WaitWithDefaultTimeout()
+		Expect(kube.ExitCode()).To(Equal(0))
+
+		// Only the first replica publishes the port of the Service.
+		inspect := podmanTest.Podman([]string{"pod", "inspect", "repldeploy-pod-0", "--format", "{{ .InfraConfig.PortBindings }}"})
+		inspect.WaitWithDefaultTimeout()
+		Expect(inspect.ExitCode()).To(Equal(0))
+		Expect(inspect.OutputToString()).To(ContainSubstring("80/tcp"))
+		Expect(inspect.OutputToString()).To(ContainSubstring("8080"))
+
+		inspect = podmanTest.Podman([]string{"pod", "inspect", "repldeploy-pod-1", "--format", "{{ .InfraConfig.PortBindings }}"})
+		inspect.WaitWithDefaultTimeout()
+		Expect(inspect.ExitCode()).To(Equal(0))
+		Expect(inspect.OutputToString()).To(Not(ContainSubstring("8080")))
+
+		ps := podmanTest.Podman([]string{"ps", "-q", "--filter", "status=running", "--filter", "name=repldeploy-pod"})
+		ps.WaitWithDefaultTimeout()
+		Expect(ps.ExitCode()).To(Equal(0))
+		Expect(len(ps.OutputToStringArray())).To(Equal(2))
+	})
+
+	It("podman play kube with liveness and startup probes", func() {
+		err := writeYaml(probesYaml, kubeYaml)
+		Expect(err).To(BeNil())
+
+		kube := podmanTest.Podman([]string{"play", "kube", kubeYaml})
+		kube.WaitWithDefaultTimeout()
+		Expect(kube.ExitCode()).To(Equal(0))
+
+		inspect := podmanTest.Podman([]string{"inspect", "probespod-ctr", "--format", "{{ .Config.Healthcheck.Test }} {{ .Config.Healthcheck.Interval }} {{ .Config.Healthcheck.Retries }}"})
+		inspect.WaitWithDefaultTimeout()
+		Expect(inspect.ExitCode()).To(Equal(0))
+		Expect(inspect.OutputToString()).To(Equal("[CMD cat /tmp/healthy] 20s 2"))
+
+		inspect = podmanTest.Podman([]string{"inspect", "probespod-ctr", "--format", "{{ .Config.StartupHealthCheck.Test }} {{ .Config.StartupHealthCheck.Interval }}"})
+		inspect.WaitWithDefaultTimeout()
+		Expect(inspect.ExitCode()).To(Equal(0))
+		Expect(inspect.OutputToString()).To(Equal("[CMD cat /tmp/started] 5s"))
+	})
 })
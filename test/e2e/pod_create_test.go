@@ -496,4 +496,38 @@ entrypoint ["/fromimage"]
 		Expect(session.ExitCode()).To(Equal(125))
 		Expect(session.ErrorToString()).To(ContainSubstring("pods presently do not support network mode container"))
 	})
+
+	It("podman pod create with resource limits", func() {
+		SkipIfRootless("resource limits on the pod cgroup require cgroup v2 and systemd as rootless")
+		podName := "limitedpod"
+		session := podmanTest.Podman([]string{"pod", "create", "--name", podName, "--cpus", "0.5", "--memory", "256m", "--pids-limit", "100"})
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Equal(0))
+
+		inspect := podmanTest.Podman([]string{"pod", "inspect", "--format", "{{.CPUPeriod}} {{.CPUQuota}} {{.MemoryLimit}} {{.PidsLimit}}", podName})
+		inspect.WaitWithDefaultTimeout()
+		Expect(inspect.ExitCode()).To(Equal(0))
+		Expect(inspect.OutputToString()).To(Equal("100000 50000 268435456 100"))
+
+		session = podmanTest.Podman([]string{"run", "-d", "--pod", podName, "--name", "limitedctr", ALPINE, "top"})
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Equal(0))
+
+		kube := podmanTest.Podman([]string{"generate", "kube", podName})
+		kube.WaitWithDefaultTimeout()
+		Expect(kube.ExitCode()).To(Equal(0))
+		Expect(kube.OutputToString()).To(ContainSubstring("io.podman.annotations.pod-limits/cpu: 500m"))
+		Expect(kube.OutputToString()).To(ContainSubstring("io.podman.annotations.pod-limits/memory: 256Mi"))
+		Expect(kube.OutputToString()).To(ContainSubstring(`io.podman.annotations.pod-limits/pids: "100"`))
+	})
+
+	It("podman pod create with invalid resource limits", func() {
+		session := podmanTest.Podman([]string{"pod", "create", "--memory", "lots"})
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Equal(125))
+
+		session = podmanTest.Podman([]string{"pod", "create", "--cpus", "-1"})
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Equal(125))
+	})
 })
@@ -0,0 +1,136 @@
+package integration
+
+import (
+	"os"
+
+	. "github.com/containers/podman/v2/test/utils"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("podman rename", func() {
+	var (
+		tempdir    string
+		err        error
+		podmanTest *PodmanTestIntegration
+	)
+
+	BeforeEach(func() {
+		tempdir, err = CreateTempDirInTempDir()
+		if err != nil {
+			os.Exit(1)
+		}
+		podmanTest = PodmanTestCreate(tempdir)
+		podmanTest.Setup()
+		podmanTest.SeedImages()
+	})
+
+	AfterEach(func() {
+		podmanTest.Cleanup()
+		f := CurrentGinkgoTestDescription()
+		processTestResult(f)
+
+	})
+
+	It("podman rename on non-existent container", func() {
+		session := podmanTest.Podman([]string{"rename", "doesNotExist", "aNewName"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).To(ExitWithError())
+	})
+
+	It("Podman rename on existing container with bad name", func() {
+		ctrName := "testCtr"
+		ctr := podmanTest.Podman([]string{"create", "--name", ctrName, ALPINE, "top"})
+		ctr.WaitWithDefaultTimeout()
+		Expect(ctr).To(Exit(0))
+
+		rename := podmanTest.Podman([]string{"rename", ctrName, "invalid<>:char"})
+		rename.WaitWithDefaultTimeout()
+		Expect(rename).To(ExitWithError())
+
+		ps := podmanTest.Podman([]string{"ps", "-aq", "--filter", "name=" + ctrName, "--format", "{{ .Names }}"})
+		ps.WaitWithDefaultTimeout()
+		Expect(ps).To(Exit(0))
+		Expect(ps.OutputToString()).To(Equal(ctrName))
+	})
+
+	It("Successfully rename a created container", func() {
+		ctrName := "testCtr"
+		ctr := podmanTest.Podman([]string{"create", "--name", ctrName, ALPINE, "top"})
+		ctr.WaitWithDefaultTimeout()
+		Expect(ctr).To(Exit(0))
+
+		newName := "aNewName"
+		rename := podmanTest.Podman([]string{"rename", ctrName, newName})
+		rename.WaitWithDefaultTimeout()
+		Expect(rename).To(Exit(0))
+
+		ps := podmanTest.Podman([]string{"ps", "-aq", "--filter", "name=" + newName, "--format", "{{ .Names }}"})
+		ps.WaitWithDefaultTimeout()
+		Expect(ps).To(Exit(0))
+		Expect(ps.OutputToString()).To(Equal(newName))
+
+		// The old name can be reused.
+		reuse := podmanTest.Podman([]string{"create", "--name", ctrName, ALPINE, "top"})
+		reuse.WaitWithDefaultTimeout()
+		Expect(reuse).To(Exit(0))
+	})
+
+	It("Successfully rename a running container", func() {
+		ctrName := "testCtr"
+		ctr := podmanTest.Podman([]string{"run", "-d", "--name", ctrName, ALPINE, "top"})
+		ctr.WaitWithDefaultTimeout()
+		Expect(ctr).To(Exit(0))
+
+		newName := "aNewName"
+		rename := podmanTest.Podman([]string{"container", "rename", ctrName, newName})
+		rename.WaitWithDefaultTimeout()
+		Expect(rename).To(Exit(0))
+
+		inspect := podmanTest.Podman([]string{"inspect", "--format", "{{ .Name }} {{ .State.Status }}", newName})
+		inspect.WaitWithDefaultTimeout()
+		Expect(inspect).To(Exit(0))
+		Expect(inspect.OutputToString()).To(Equal(newName + " running"))
+
+		stop := podmanTest.Podman([]string{"stop", "-t", "0", newName})
+		stop.WaitWithDefaultTimeout()
+		Expect(stop).To(Exit(0))
+	})
+
+	It("Rename to a name in use by a container or pod fails", func() {
+		ctr1 := podmanTest.Podman([]string{"create", "--name", "ctr1", ALPINE, "top"})
+		ctr1.WaitWithDefaultTimeout()
+		Expect(ctr1).To(Exit(0))
+
+		ctr2 := podmanTest.Podman([]string{"create", "--name", "ctr2", ALPINE, "top"})
+		ctr2.WaitWithDefaultTimeout()
+		Expect(ctr2).To(Exit(0))
+
+		pod := podmanTest.Podman([]string{"pod", "create", "--name", "pod1"})
+		pod.WaitWithDefaultTimeout()
+		Expect(pod).To(Exit(0))
+
+		for _, name := range []string{"ctr2", "pod1"} {
+			rename := podmanTest.Podman([]string{"rename", "ctr1", name})
+			rename.WaitWithDefaultTimeout()
+			Expect(rename).To(ExitWithError())
+		}
+	})
+
+	It("Rename a container in a pod", func() {
+		ctr := podmanTest.Podman([]string{"create", "--pod", "new:pod1", "--name", "ctr1", ALPINE, "top"})
+		ctr.WaitWithDefaultTimeout()
+		Expect(ctr).To(Exit(0))
+
+		rename := podmanTest.Podman([]string{"rename", "ctr1", "ctr2"})
+		rename.WaitWithDefaultTimeout()
+		Expect(rename).To(Exit(0))
+
+		ps := podmanTest.Podman([]string{"ps", "-a", "--pod", "--filter", "pod=pod1", "--format", "{{ .Names }}"})
+		ps.WaitWithDefaultTimeout()
+		Expect(ps).To(Exit(0))
+		Expect(ps.OutputToString()).To(ContainSubstring("ctr2"))
+		Expect(ps.OutputToString()).To(Not(ContainSubstring("ctr1")))
+	})
+})
@@ -0,0 +1,154 @@
+package integration
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/containers/podman/v2/test/utils"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Podman secret", func() {
+	var (
+		tempdir    string
+		err        error
+		podmanTest *PodmanTestIntegration
+		secretFile string
+	)
+
+	BeforeEach(func() {
+		tempdir, err = CreateTempDirInTempDir()
+		if err != nil {
+			os.Exit(1)
+		}
+		podmanTest = PodmanTestCreate(tempdir)
+		podmanTest.Setup()
+		podmanTest.SeedImages()
+		secretFile = filepath.Join(podmanTest.TempDir, "secret")
+		err = ioutil.WriteFile(secretFile, []byte("mysecret"), 0755)
+		Expect(err).To(BeNil())
+	})
+
+	AfterEach(func() {
+		podmanTest.Cleanup()
+		f := CurrentGinkgoTestDescription()
+		processTestResult(f)
+	})
+
+	It("podman secret create and inspect", func() {
+		session := podmanTest.Podman([]string{"secret", "create", "a", secretFile})
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Equal(0))
+		secrID := session.OutputToString()
+
+		inspect := podmanTest.Podman([]string{"secret", "inspect", "--format", "{{.ID}}", secrID})
+		inspect.WaitWithDefaultTimeout()
+		Expect(inspect.ExitCode()).To(Equal(0))
+		Expect(inspect.OutputToString()).To(Equal(secrID))
+
+		inspect = podmanTest.Podman([]string{"secret", "inspect", "--format", "{{.Spec.Name}}", "a"})
+		inspect.WaitWithDefaultTimeout()
+		Expect(inspect.ExitCode()).To(Equal(0))
+		Expect(inspect.OutputToString()).To(Equal("a"))
+	})
+
+	It("podman secret create with bad name should fail", func() {
+		session := podmanTest.Podman([]string{"secret", "create", "?!", secretFile})
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Not(Equal(0)))
+	})
+
+	It("podman secret create with duplicate name should fail", func() {
+		session := podmanTest.Podman([]string{"secret", "create", "a", secretFile})
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Equal(0))
+
+		session = podmanTest.Podman([]string{"secret", "create", "a", secretFile})
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Not(Equal(0)))
+	})
+
+	It("podman secret ls", func() {
+		session := podmanTest.Podman([]string{"secret", "create", "a", secretFile})
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Equal(0))
+
+		list := podmanTest.Podman([]string{"secret", "ls"})
+		list.WaitWithDefaultTimeout()
+		Expect(list.ExitCode()).To(Equal(0))
+		Expect(len(list.OutputToStringArray())).To(Equal(2))
+
+		list = podmanTest.Podman([]string{"secret", "ls", "--format", "{{.Name}}"})
+		list.WaitWithDefaultTimeout()
+		Expect(list.ExitCode()).To(Equal(0))
+		Expect(list.OutputToString()).To(Equal("a"))
+	})
+
+	It("podman secret rm", func() {
+		session := podmanTest.Podman([]string{"secret", "create", "a", secretFile})
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Equal(0))
+		secrID := session.OutputToString()
+
+		removed := podmanTest.Podman([]string{"secret", "rm", "a"})
+		removed.WaitWithDefaultTimeout()
+		Expect(removed.ExitCode()).To(Equal(0))
+		Expect(removed.OutputToString()).To(Equal(secrID))
+
+		list := podmanTest.Podman([]string{"secret", "ls", "--noheading"})
+		list.WaitWithDefaultTimeout()
+		Expect(list.ExitCode()).To(Equal(0))
+		Expect(len(list.OutputToStringArray())).To(Equal(0))
+	})
+
+	It("podman secret rm --all", func() {
+		session := podmanTest.Podman([]string{"secret", "create", "a", secretFile})
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Equal(0))
+		session = podmanTest.Podman([]string{"secret", "create", "b", secretFile})
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Equal(0))
+
+		removed := podmanTest.Podman([]string{"secret", "rm", "--all"})
+		removed.WaitWithDefaultTimeout()
+		Expect(removed.ExitCode()).To(Equal(0))
+
+		list := podmanTest.Podman([]string{"secret", "ls", "--noheading"})
+		list.WaitWithDefaultTimeout()
+		Expect(list.ExitCode()).To(Equal(0))
+		Expect(len(list.OutputToStringArray())).To(Equal(0))
+	})
+
+	It("podman run --secret", func() {
+		session := podmanTest.Podman([]string{"secret", "create", "mysecret", secretFile})
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Equal(0))
+
+		session = podmanTest.Podman([]string{"run", "--secret", "mysecret", "--name", "secr", ALPINE, "cat", "/run/secrets/mysecret"})
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Equal(0))
+		Expect(session.OutputToString()).To(Equal("mysecret"))
+
+		// a secret in use by a container cannot be removed
+		removed := podmanTest.Podman([]string{"secret", "rm", "mysecret"})
+		removed.WaitWithDefaultTimeout()
+		Expect(removed.ExitCode()).To(Not(Equal(0)))
+	})
+
+	It("podman run --secret type=env", func() {
+		session := podmanTest.Podman([]string{"secret", "create", "mysecret", secretFile})
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Equal(0))
+
+		session = podmanTest.Podman([]string{"run", "--secret", "source=mysecret,type=env,target=MYSECRET", ALPINE, "printenv", "MYSECRET"})
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Equal(0))
+		Expect(session.OutputToString()).To(Equal("mysecret"))
+
+		session = podmanTest.Podman([]string{"run", "--secret", "mysecret,type=mount,target=MYSECRET", ALPINE, "true"})
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Not(Equal(0)))
+	})
+})
@@ -19,7 +19,6 @@ var _ = Describe("Podman trust", func() {
 	)
 
 	BeforeEach(func() {
-		SkipIfRemote("podman-remote does not support image trust")
 		tempdir, err = CreateTempDirInTempDir()
 		if err != nil {
 			os.Exit(1)
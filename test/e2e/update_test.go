@@ -0,0 +1,92 @@
+package integration
+
+import (
+	"os"
+
+	. "github.com/containers/podman/v2/test/utils"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("podman update", func() {
+	var (
+		tempdir    string
+		err        error
+		podmanTest *PodmanTestIntegration
+	)
+
+	BeforeEach(func() {
+		SkipIfRootlessCgroupsV1("Resource limits are not supported with rootless cgroups v1")
+		tempdir, err = CreateTempDirInTempDir()
+		if err != nil {
+			os.Exit(1)
+		}
+		podmanTest = PodmanTestCreate(tempdir)
+		podmanTest.Setup()
+		podmanTest.SeedImages()
+	})
+
+	AfterEach(func() {
+		podmanTest.Cleanup()
+		f := CurrentGinkgoTestDescription()
+		processTestResult(f)
+
+	})
+
+	It("podman update on non-existent container", func() {
+		session := podmanTest.Podman([]string{"update", "--cpu-shares", "512", "doesNotExist"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).To(ExitWithError())
+	})
+
+	It("podman update without resource limits", func() {
+		ctr := podmanTest.Podman([]string{"create", "--name", "test", ALPINE, "top"})
+		ctr.WaitWithDefaultTimeout()
+		Expect(ctr).To(Exit(0))
+
+		session := podmanTest.Podman([]string{"update", "test"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).To(ExitWithError())
+	})
+
+	It("podman update a created container", func() {
+		ctr := podmanTest.Podman([]string{"create", "--name", "test", "--cpu-shares", "1024", ALPINE, "top"})
+		ctr.WaitWithDefaultTimeout()
+		Expect(ctr).To(Exit(0))
+
+		session := podmanTest.Podman([]string{"update", "--cpu-shares", "512", "--pids-limit", "100", "test"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).To(Exit(0))
+
+		inspect := podmanTest.Podman([]string{"inspect", "--format", "{{.HostConfig.CpuShares}} {{.HostConfig.PidsLimit}}", "test"})
+		inspect.WaitWithDefaultTimeout()
+		Expect(inspect).To(Exit(0))
+		Expect(inspect.OutputToString()).To(Equal("512 100"))
+	})
+
+	It("podman update a running container", func() {
+		ctr := podmanTest.Podman([]string{"run", "-d", "--name", "test", ALPINE, "top"})
+		ctr.WaitWithDefaultTimeout()
+		Expect(ctr).To(Exit(0))
+
+		session := podmanTest.Podman([]string{"update", "--memory", "256m", "--memory-swap", "512m", "test"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).To(Exit(0))
+
+		inspect := podmanTest.Podman([]string{"inspect", "--format", "{{.HostConfig.Memory}} {{.HostConfig.MemorySwap}}", "test"})
+		inspect.WaitWithDefaultTimeout()
+		Expect(inspect).To(Exit(0))
+		Expect(inspect.OutputToString()).To(Equal("268435456 536870912"))
+
+		// The limits must be kept when the container is restarted.
+		restart := podmanTest.Podman([]string{"restart", "test"})
+		restart.WaitWithDefaultTimeout()
+		Expect(restart).To(Exit(0))
+
+		inspect = podmanTest.Podman([]string{"inspect", "--format", "{{.HostConfig.Memory}}", "test"})
+		inspect.WaitWithDefaultTimeout()
+		Expect(inspect).To(Exit(0))
+		Expect(inspect.OutputToString()).To(Equal("268435456"))
+	})
+})
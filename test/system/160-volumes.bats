@@ -247,4 +247,25 @@ EOF
 }
 
 
+# Archives hold the IDs seen by containers, also when run rootless
+@test "podman volume export/import" {
+    myvol=myvol$(random_string)
+    run_podman volume create $myvol
+    run_podman run --rm -v $myvol:/vol $IMAGE \
+               sh -c "echo hello >/vol/myfile && chown 1000:1000 /vol/myfile"
+
+    tarball=${PODMAN_TMPDIR}/$myvol.tar
+    run_podman volume export -o $tarball $myvol
+    run tar -tvf $tarball --numeric-owner
+    is "$output" ".* 1000/1000 .* myfile" "file in archive is owned by 1000:1000"
+
+    myvol2=myvol2$(random_string)
+    run_podman volume create $myvol2
+    run_podman volume import $myvol2 $tarball
+    run_podman run --rm -v $myvol2:/vol $IMAGE \
+               stat -c "%u:%g %n" /vol/myfile
+    is "$output" "1000:1000 /vol/myfile" "imported file is owned by 1000:1000"
+}
+
+
 # vim: filetype=sh
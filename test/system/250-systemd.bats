@@ -81,4 +81,80 @@ function teardown() {
     $SYSTEMCTL daemon-reload
 }
 
+# An update to an image that never becomes healthy is rolled back, and the
+# same image is not tried again on the next run.
+@test "podman auto-update - rollback" {
+    # podman initializes this if unset, but systemctl doesn't
+    if is_rootless; then
+        if [ -z "$XDG_RUNTIME_DIR" ]; then
+            export XDG_RUNTIME_DIR=/run/user/$(id -u)
+        fi
+    fi
+
+    cname=$(random_string)
+    imgname=localhost/autoupdate_$(random_string | tr A-Z a-z):latest
+    run_podman tag $IMAGE $imgname
+    run_podman inspect --format "{{.ID}}" $imgname
+    oldid="$output"
+
+    run_podman create --name $cname --label "io.containers.autoupdate=local" $imgname top
+    run_podman generate systemd --new $cname
+    echo "$output" > "$UNIT_FILE"
+    run_podman rm $cname
+
+    $SYSTEMCTL daemon-reload
+
+    run $SYSTEMCTL start "$SERVICE_NAME"
+    if [ $status -ne 0 ]; then
+        die "Error starting systemd unit $SERVICE_NAME, output: $output"
+    fi
+
+    run_podman inspect --format "{{.ID}}" $cname
+    firstcid="$output"
+
+    # Build an image that never becomes healthy under the same name.
+    tmpdir=$PODMAN_TMPDIR/build-autoupdate
+    mkdir -p $tmpdir
+    cat >$tmpdir/Containerfile <<EOF
+FROM $IMAGE
+HEALTHCHECK --interval=1s --timeout=1s --retries=1 CMD false
+EOF
+    run_podman build -t $imgname --format=docker $tmpdir
+    run_podman inspect --format "{{.ID}}" $imgname
+    badid="$output"
+
+    run_podman 125 auto-update --rollback --format "{{.SystemdUnit}},{{.Updated}}"
+    is "$output" ".*$SERVICE_NAME.service,rolled back.*" "update is rolled back"
+
+    run_podman inspect --format "{{.ID}}" $imgname
+    is "$output" "$oldid" "previous image is tagged again"
+
+    run $SYSTEMCTL is-active "$SERVICE_NAME"
+    is "$output" "active" "systemd unit is restarted"
+    run_podman inspect --format "{{.Image}} {{.State.Status}}" $cname
+    is "$output" "$oldid running" "restarted container runs the previous image"
+    run_podman inspect --format "{{.ID}}" $cname
+    cid="$output"
+    if [[ "$cid" == "$firstcid" ]]; then
+        die "systemd unit has not been restarted, container $cid is still running"
+    fi
+
+    # The rolled back image is skipped, even if it is tagged again.
+    run_podman tag $badid $imgname
+    run_podman auto-update --rollback --format "{{.SystemdUnit}},{{.Updated}}"
+    is "$output" "$SERVICE_NAME.service,false" "rolled back image is not updated to again"
+    run_podman inspect --format "{{.ID}}" $cname
+    is "$output" "$cid" "systemd unit is not restarted again"
+
+    # All good. Stop service, clean up.
+    run $SYSTEMCTL stop "$SERVICE_NAME"
+    if [ $status -ne 0 ]; then
+        die "Error stopping systemd unit $SERVICE_NAME, output: $output"
+    fi
+
+    rm -f "$UNIT_FILE"
+    $SYSTEMCTL daemon-reload
+    run_podman rmi -f $badid
+}
+
 # vim: filetype=sh